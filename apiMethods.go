@@ -2,106 +2,140 @@ package robloxgo
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 )
 
 // get is an internal method that sends an internal HTTP GET request to the specified URL.
 //
-// It returns the HTTP response if the status code is 200 (OK).
-// If the response status code is not 200, it returns an error
-// containing the status and response body.
+// It returns the HTTP response if the status code is 2xx.
+// If the response status code is not 2xx, it returns an *APIError
+// describing the status and response body.
 //
 // The functions caller must close the returned response body.
-func (c *Client) get(methodURL string, headers []httpHeader, parameters []queryParam) (*http.Response, error) {
-	req, err := newHttpRequest(http.MethodGet, methodURL, nil, headers, parameters)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-
-	if err := httpErrorCheck(resp); err != nil {
-		return nil, err
-	}
-
-	return resp, nil
+func (c *Client) get(ctx context.Context, methodURL string, headers []httpHeader, parameters []queryParam) (*http.Response, error) {
+	return c.do(ctx, http.MethodGet, methodURL, nil, headers, parameters, true)
 }
 
 // post is an internal method that sends a HTTP POST request to the specified URL with optional headers and a request body.
 //
-// It returns the HTTP response if the status code is 200 (OK).
-// If the status code is not 200, it returns an error containing the status and response body.
+// It returns the HTTP response if the status code is 2xx.
+// If the status code is not 2xx, it returns an *APIError describing the status and response body.
+//
+// POST requests are treated as non-idempotent, so they are only retried when the
+// API reports that the request was rate limited and therefore never processed.
 //
 // The caller must close the response body.
-func (c *Client) post(methodURL string, body interface{}, headers []httpHeader, parameters []queryParam) (*http.Response, error) {
-	req, err := newHttpRequest(http.MethodPost, methodURL, body, headers, parameters)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-
-	if err := httpErrorCheck(resp); err != nil {
-		return nil, err
-	}
+func (c *Client) post(ctx context.Context, methodURL string, body interface{}, headers []httpHeader, parameters []queryParam) (*http.Response, error) {
+	return c.do(ctx, http.MethodPost, methodURL, body, headers, parameters, false)
+}
 
-	return resp, nil
+// postIdempotent is like post, but for POST endpoints that only read data (such as the
+// legacy bulk user lookups) and are therefore safe to retry under the full retry policy.
+//
+// The caller must close the response body.
+func (c *Client) postIdempotent(ctx context.Context, methodURL string, body interface{}, headers []httpHeader, parameters []queryParam) (*http.Response, error) {
+	return c.do(ctx, http.MethodPost, methodURL, body, headers, parameters, true)
 }
 
-// patch is an internal method that sends a HTTP PATCH request to the specified URL with optional headers.
+// patch is an internal method that sends a HTTP PATCH request to the specified URL with optional headers and a request body.
 //
-// It returns the HTTP response if the status code is 200 (OK).
-// If the status code is not 200, it returns an error containing the status and response body.
-func (c *Client) patch(methodURL string, headers []httpHeader, body interface{}) (bool, error) {
-	req, err := newHttpRequest(http.MethodPatch, methodURL, body, headers, nil)
-	if err != nil {
-		return false, err
-	}
+// It returns the HTTP response if the status code is 2xx.
+// If the status code is not 2xx, it returns an *APIError describing the status and response body.
+//
+// The caller must close the response body.
+func (c *Client) patch(ctx context.Context, methodURL string, body interface{}, headers []httpHeader, parameters []queryParam) (*http.Response, error) {
+	return c.do(ctx, http.MethodPatch, methodURL, body, headers, parameters, true)
+}
 
-	resp, err := c.client.Do(req)
+// delete is an internal method that sends a HTTP DELETE request to the specified URL with optional headers.
+//
+// It returns the HTTP response if the status code is 2xx.
+// If the status code is not 2xx, it returns an *APIError describing the status and response body.
+func (c *Client) delete(ctx context.Context, methodURL string, headers []httpHeader) (bool, error) {
+	resp, err := c.do(ctx, http.MethodDelete, methodURL, nil, headers, nil, true)
 	if err != nil {
 		return false, err
 	}
-	defer resp.Body.Close()
-
-	if err := httpErrorCheck(resp); err != nil {
-		return false, err
-	}
+	resp.Body.Close()
 
 	return true, nil
 }
 
-// delete is an internal method that sends a HTTP DELETE request to the specified URL with optional headers.
+// do is the internal method that every request helper goes through.
 //
-// It returns the HTTP response if the status code is 200 (OK).
-// If the status code is not 200, it returns an error containing the status and response body.
-func (c *Client) delete(methodURL string, headers []httpHeader) (bool, error) {
-	req, err := newHttpRequest(http.MethodDelete, methodURL, nil, headers, nil)
-	if err != nil {
-		return false, err
-	}
+// Every attempt first waits on the client's rate limiter. It then builds a fresh request,
+// tagged with the client's User-Agent, and retries failed attempts according to the
+// client's RetryPolicy. Requests that are not idempotent are only retried when the API
+// responds with 429 Too Many Requests, as the request was not processed.
+// Waits between attempts are cut short if the context is cancelled.
+//
+// It returns the HTTP response if the status code is 2xx, otherwise the last error seen.
+// The caller must close the response body.
+func (c *Client) do(ctx context.Context, method string, methodURL string, body interface{}, headers []httpHeader, parameters []queryParam, idempotent bool) (*http.Response, error) {
+	policy := c.retryPolicy
+	bucket := c.bucketFor(methodURL)
+	for attempt := 1; ; attempt++ {
+		if c.limiter != nil {
+			if err := c.limiter.Wait(ctx, bucket); err != nil {
+				return nil, err
+			}
+		}
 
-	resp, err := c.client.Do(req)
-	if err != nil {
-		return false, err
-	}
-	defer resp.Body.Close()
+		req, err := newHttpRequest(ctx, method, methodURL, body, headers, parameters)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("User-Agent", c.userAgent)
+
+		resp, err := c.client.Do(req)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			if !idempotent || attempt >= policy.MaxAttempts {
+				return nil, err
+			}
+			wait := policy.backoff(attempt)
+			c.logf("robloxgo: retrying %s %s in %v (attempt %d/%d): %v", method, methodURL, wait, attempt+1, policy.MaxAttempts, err)
+			if err := sleepContext(ctx, wait); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if c.limiter != nil {
+			c.limiter.observe(bucket, resp)
+		}
+
+		err = httpErrorCheck(resp)
+		if err == nil {
+			return resp, nil
+		}
+		if attempt >= policy.MaxAttempts || !policy.shouldRetry(resp.StatusCode, idempotent) {
+			return nil, err
+		}
 
-	if resp.StatusCode != http.StatusOK {
-		return false, fmt.Errorf("http error %d: %s", resp.StatusCode, resp.Status)
+		wait := policy.retryDelay(attempt, resp)
+		c.logf("robloxgo: retrying %s %s in %v (attempt %d/%d): %v", method, methodURL, wait, attempt+1, policy.MaxAttempts, err)
+		if err := sleepContext(ctx, wait); err != nil {
+			return nil, err
+		}
 	}
+}
 
-	return true, nil
+// logf reports a message to the client's Logger, if one is set.
+func (c *Client) logf(format string, v ...interface{}) {
+	if c.logger != nil {
+		c.logger.Printf(format, v...)
+	}
 }
 
 type httpHeader struct {
@@ -111,6 +145,15 @@ type httpHeader struct {
 	Value string
 }
 
+// rawBody is a request body sent as is, rather than encoded as JSON, such as an uploaded file.
+// It is held in memory so that the request can be rebuilt when it is retried.
+type rawBody struct {
+	// The bytes sent as the request body
+	data []byte
+	// The Content-Type of the request body
+	contentType string
+}
+
 type queryParam struct {
 	// The key for the query parameter
 	Key string
@@ -120,35 +163,38 @@ type queryParam struct {
 
 // httpErrorCheck validates the HTTP response status code.
 //
-// If the response status is not 200 OK, it reads and preserves the response body,
-// then returns a formatted error including the status and response body contents.
+// If the response status is not 2xx, it reads and preserves the response body,
+// then returns an *APIError describing the failed request, parsed from the response body.
 //
 // The response body is restored using io.NopCloser so it can still be read after the check.
-// If the body cannot be read, a fallback error message is returned instead.
+// If the body cannot be read, the APIError is returned without the body contents.
 func httpErrorCheck(resp *http.Response) error {
-	if resp.StatusCode == http.StatusOK {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
 		return nil
 	}
 
 	bodyBytes, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
 	if err != nil {
-		return fmt.Errorf("http error %s: unable to read body: %v", resp.Status, err)
+		return newAPIError(resp, nil)
 	}
 
 	resp.Body = io.NopCloser(bytes.NewBuffer(bodyBytes))
-	return fmt.Errorf("http error %s: %s", resp.Status, string(bodyBytes))
+	return newAPIError(resp, bodyBytes)
 }
 
 // newHttpRequest constructs an HTTP request with optional query parameters, headers, and a JSON body.
 //
-// It accepts the HTTP method, request URL, optional request body (which is JSON-encoded if not nil),
+// It accepts the request context, the HTTP method, request URL, optional request body (which is JSON-encoded if not nil),
 // custom headers, and query parameters.
 //
 // If a body is provided and the method is not GET, the Content-Type is set to application/json.
-// A User-Agent header is also added, including the library and Go runtime version.
+// A rawBody is sent unencoded instead, with its own Content-Type.
+// A User-Agent header is also added, including the library and Go runtime version,
+// which the client may replace with its own.
 //
 // Returns the constructed *http.Request or an error if the request cannot be created.
-func newHttpRequest(method string, methodURL string, body interface{}, headers []httpHeader, parameters []queryParam) (*http.Request, error) {
+func newHttpRequest(ctx context.Context, method string, methodURL string, body interface{}, headers []httpHeader, parameters []queryParam) (*http.Request, error) {
 	parsedURL, _ := url.Parse(methodURL)
 
 	q := parsedURL.Query()
@@ -158,17 +204,23 @@ func newHttpRequest(method string, methodURL string, body interface{}, headers [
 	parsedURL.RawQuery = q.Encode()
 
 	var requestBody bytes.Buffer
-	if body != nil {
+	contentType := "application/json"
+	switch body := body.(type) {
+	case nil:
+	case rawBody:
+		requestBody.Write(body.data)
+		contentType = body.contentType
+	default:
 		json.NewEncoder(&requestBody).Encode(body)
 	}
 
-	req, err := http.NewRequest(method, parsedURL.String(), &requestBody)
+	req, err := http.NewRequestWithContext(ctx, method, parsedURL.String(), &requestBody)
 	if err != nil {
 		return nil, err
 	}
 
 	if method != http.MethodGet && body != nil {
-		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Content-Type", contentType)
 	}
 
 	for _, header := range headers {
@@ -179,3 +231,98 @@ func newHttpRequest(method string, methodURL string, body interface{}, headers [
 
 	return req, nil
 }
+
+// forEachChunk splits total items into chunks of up to chunkSize and calls fn for each chunk
+// with the [start, end) bounds, running at most concurrency calls at once.
+//
+// The first error returned by fn cancels the context passed to the remaining calls and is returned.
+func forEachChunk(ctx context.Context, total int, chunkSize int, concurrency int, fn func(ctx context.Context, start, end int) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	semaphore := make(chan struct{}, concurrency)
+
+dispatch:
+	for start := 0; start < total; start += chunkSize {
+		end := start + chunkSize
+		if end > total {
+			end = total
+		}
+
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+			break dispatch
+		}
+
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			if err := fn(ctx, start, end); err != nil {
+				errOnce.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}
+		}(start, end)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+
+	return ctx.Err()
+}
+
+// sleepContext pauses for the given duration or until the context is done, whichever comes first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	return waitContext(ctx, timer.C)
+}
+
+// waitContext blocks until the channel fires or the context is done, whichever comes first.
+//
+// It is used between paginated requests so that callers can cancel a long running
+// crawl without waiting for the next tick. Returns the context error if the context ends first.
+func waitContext(ctx context.Context, ch <-chan time.Time) error {
+	select {
+	case <-ch:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// formatDuration formats a duration in the Open Cloud wire format, such as "30s".
+func formatDuration(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + "s"
+}
+
+// parseDuration parses a duration in the Open Cloud wire format, such as "30s" or "1.5s".
+// An empty string is parsed as zero.
+func parseDuration(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, nil
+	}
+
+	seconds, err := strconv.ParseFloat(strings.TrimSuffix(s, "s"), 64)
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Duration(seconds * float64(time.Second)), nil
+}
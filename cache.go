@@ -0,0 +1,182 @@
+package robloxgo
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+// Cache stores API responses that rarely change, such as user profiles and group roles.
+//
+// Implementations must be safe for concurrent use. The default implementation is
+// an in-memory LRU cache created with NewMemoryCache.
+type Cache interface {
+	// Get returns the value stored under key, if present and not expired.
+	Get(key string) (interface{}, bool)
+
+	// Set stores value under key for the given time to live.
+	Set(key string, value interface{}, ttl time.Duration)
+
+	// Delete removes the value stored under key, if any.
+	Delete(key string)
+}
+
+// CacheResource identifies a type of resource stored in the client's Cache.
+type CacheResource string
+
+// Resources cached by the client, each with their own time to live.
+const (
+	CacheUsers       CacheResource = "user"
+	CacheGroups      CacheResource = "group"
+	CacheRoles       CacheResource = "role"
+	CacheMemberships CacheResource = "membership"
+)
+
+// DefaultCacheTTLs are the times to live used by clients created without WithCacheTTL.
+var DefaultCacheTTLs = map[CacheResource]time.Duration{
+	CacheUsers:       10 * time.Minute,
+	CacheGroups:      5 * time.Minute,
+	CacheRoles:       10 * time.Minute,
+	CacheMemberships: time.Minute,
+}
+
+// DefaultCacheSize is the capacity of the MemoryCache used by clients created without WithCache.
+const DefaultCacheSize = 10000
+
+// MemoryCache is an in-memory Cache that evicts the least recently used entry once
+// full, and treats entries as missing once their time to live has passed.
+type MemoryCache struct {
+	mu       sync.Mutex
+	capacity int
+	entries  map[string]*list.Element
+	order    *list.List
+}
+
+type memoryCacheEntry struct {
+	key       string
+	value     interface{}
+	expiresAt time.Time
+}
+
+// NewMemoryCache creates a MemoryCache holding at most capacity entries.
+func NewMemoryCache(capacity int) *MemoryCache {
+	return &MemoryCache{
+		capacity: capacity,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// Get returns the value stored under key, if present and not expired.
+func (m *MemoryCache) Get(key string) (interface{}, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	element, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := element.Value.(*memoryCacheEntry)
+	if time.Now().After(entry.expiresAt) {
+		m.order.Remove(element)
+		delete(m.entries, key)
+		return nil, false
+	}
+	m.order.MoveToFront(element)
+
+	return entry.value, true
+}
+
+// Set stores value under key for the given time to live, evicting the least
+// recently used entry if the cache is full.
+func (m *MemoryCache) Set(key string, value interface{}, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	expiresAt := time.Now().Add(ttl)
+	if element, ok := m.entries[key]; ok {
+		entry := element.Value.(*memoryCacheEntry)
+		entry.value = value
+		entry.expiresAt = expiresAt
+		m.order.MoveToFront(element)
+		return
+	}
+
+	m.entries[key] = m.order.PushFront(&memoryCacheEntry{key: key, value: value, expiresAt: expiresAt})
+	for m.capacity > 0 && m.order.Len() > m.capacity {
+		oldest := m.order.Back()
+		m.order.Remove(oldest)
+		delete(m.entries, oldest.Value.(*memoryCacheEntry).key)
+	}
+}
+
+// Delete removes the value stored under key, if any.
+func (m *MemoryCache) Delete(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if element, ok := m.entries[key]; ok {
+		m.order.Remove(element)
+		delete(m.entries, key)
+	}
+}
+
+// Len returns the number of entries in the cache, including expired entries not yet evicted.
+func (m *MemoryCache) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.order.Len()
+}
+
+type bypassCacheKey struct{}
+
+// WithoutCache returns a context that makes any client method it is passed to skip
+// cached values and fetch fresh data. The fresh data is still stored in the cache.
+func WithoutCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bypassCacheKey{}, true)
+}
+
+// cacheKey builds the key of a cached resource from its identifying parts.
+func cacheKey(resource CacheResource, parts ...string) string {
+	key := string(resource)
+	for _, part := range parts {
+		key += ":" + part
+	}
+
+	return key
+}
+
+// cacheGet returns the cached value under key, unless caching is disabled for the
+// resource or the context asks to bypass the cache.
+func (c *Client) cacheGet(ctx context.Context, resource CacheResource, key string) (interface{}, bool) {
+	if c.cache == nil || c.cacheTTLs[resource] <= 0 {
+		return nil, false
+	}
+	if bypass, _ := ctx.Value(bypassCacheKey{}).(bool); bypass {
+		return nil, false
+	}
+
+	return c.cache.Get(key)
+}
+
+// cacheSet stores value under key with the time to live configured for the resource.
+func (c *Client) cacheSet(resource CacheResource, key string, value interface{}) {
+	if c.cache == nil || c.cacheTTLs[resource] <= 0 {
+		return
+	}
+
+	c.cache.Set(key, value, c.cacheTTLs[resource])
+}
+
+// cacheDelete invalidates the provided keys after a write.
+func (c *Client) cacheDelete(keys ...string) {
+	if c.cache == nil {
+		return
+	}
+
+	for _, key := range keys {
+		c.cache.Delete(key)
+	}
+}
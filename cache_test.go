@@ -0,0 +1,95 @@
+package robloxgo
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestMemoryCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	cache := NewMemoryCache(2)
+	cache.Set("a", 1, time.Minute)
+	cache.Set("b", 2, time.Minute)
+	cache.Get("a")
+	cache.Set("c", 3, time.Minute)
+
+	if _, ok := cache.Get("b"); ok {
+		t.Fatal("expected b to be evicted")
+	}
+	if value, ok := cache.Get("a"); !ok || value.(int) != 1 {
+		t.Fatalf("expected a to be cached, got %v", value)
+	}
+	if cache.Len() != 2 {
+		t.Fatalf("expected 2 entries, got %d", cache.Len())
+	}
+}
+
+func TestMemoryCache_Expires(t *testing.T) {
+	cache := NewMemoryCache(10)
+	cache.Set("a", 1, time.Millisecond)
+	time.Sleep(5 * time.Millisecond)
+
+	if _, ok := cache.Get("a"); ok {
+		t.Fatal("expected a to be expired")
+	}
+}
+
+func TestGetUserByID_Cached(t *testing.T) {
+	var calls int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Write([]byte(`{"id":"1","name":"Roblox"}`))
+	})
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.GetUserByID("1"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("expected 1 request, got %d", calls)
+	}
+
+	if _, err := client.GetUserByIDContext(WithoutCache(context.Background()), "1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected cache to be bypassed, got %d requests", calls)
+	}
+}
+
+func TestUpdateUserRole_InvalidatesMembership(t *testing.T) {
+	var membershipCalls int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/cloud/v2/groups/7/memberships":
+			membershipCalls++
+			w.Write([]byte(`{"groupMemberships":[{"user":"users/1","role":"groups/7/roles/10"}]}`))
+		case r.URL.Path == "/cloud/v2/users/1":
+			w.Write([]byte(`{"id":"1","name":"Roblox"}`))
+		case r.URL.Path == "/cloud/v2/groups/7/roles/20":
+			w.Write([]byte(`{"id":"20","displayName":"Owner","rank":255}`))
+		case r.Method == http.MethodPatch:
+			w.Write([]byte(`{}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}, WithCacheTTL(CacheRoles, 0))
+	group := &Group{ID: "7", Client: client}
+
+	group.GetMembership("1")
+	group.GetMembership("1")
+	if membershipCalls != 1 {
+		t.Fatalf("expected membership to be cached, got %d requests", membershipCalls)
+	}
+
+	if _, err := group.UpdateUserRole("1", "20"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	group.GetMembership("1")
+	if membershipCalls != 2 {
+		t.Fatalf("expected membership to be invalidated, got %d requests", membershipCalls)
+	}
+}
@@ -0,0 +1,491 @@
+package robloxgo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// entryStateDeleted is the State of a data store entry revision that deleted the entry.
+const entryStateDeleted = "DELETED"
+
+// DataStore is a handle to a standard data store of a universe, optionally within a scope.
+type DataStore struct {
+	// Name is the name of the data store.
+	Name string
+
+	// Scope is the scope of the data store. An empty scope uses the default "global" scope.
+	Scope string
+
+	// Universe is the universe the data store belongs to.
+	Universe *Universe
+}
+
+// DataStoreEntry represents an entry of a standard data store.
+type DataStoreEntry struct {
+	// Path is the Open Cloud resource path of the entry.
+	Path string
+
+	// Key is the key of the entry.
+	Key string
+
+	// Value is the JSON encoded value of the entry. Use Decode to unmarshal it.
+	Value json.RawMessage
+
+	// Etag identifies the current revision of the entry, for use in update preconditions.
+	Etag string
+
+	// RevisionID is the identifier of the entry's current revision.
+	RevisionID string
+
+	// RevisionCreatedAt is the timestamp of when the current revision was created.
+	RevisionCreatedAt time.Time
+
+	// CreatedAt is the timestamp of when the entry was created.
+	CreatedAt time.Time
+
+	// State is the state of the entry, such as "ACTIVE" or "DELETED".
+	State string
+
+	// UserIDs are the IDs of the users associated with the entry.
+	UserIDs []string
+
+	// Attributes are the custom attributes of the entry.
+	Attributes map[string]interface{}
+}
+
+// UnmarshalJSON decodes an Open Cloud data store entry, trimming the resource prefixes from its user IDs.
+func (e *DataStoreEntry) UnmarshalJSON(data []byte) error {
+	var entry struct {
+		Path              string                 `json:"path"`
+		ID                string                 `json:"id"`
+		Value             json.RawMessage        `json:"value"`
+		Etag              string                 `json:"etag"`
+		RevisionID        string                 `json:"revisionId"`
+		RevisionCreatedAt time.Time              `json:"revisionCreateTime"`
+		CreatedAt         time.Time              `json:"createTime"`
+		State             string                 `json:"state"`
+		Users             []string               `json:"users"`
+		Attributes        map[string]interface{} `json:"attributes"`
+	}
+	err := json.Unmarshal(data, &entry)
+	if err != nil {
+		return err
+	}
+
+	*e = DataStoreEntry{
+		Path:              entry.Path,
+		Key:               entry.ID,
+		Value:             entry.Value,
+		Etag:              entry.Etag,
+		RevisionID:        entry.RevisionID,
+		RevisionCreatedAt: entry.RevisionCreatedAt,
+		CreatedAt:         entry.CreatedAt,
+		State:             entry.State,
+		Attributes:        entry.Attributes,
+	}
+	for _, user := range entry.Users {
+		e.UserIDs = append(e.UserIDs, strings.TrimPrefix(user, "users/"))
+	}
+
+	return nil
+}
+
+// Decode unmarshals the entry's JSON value into v.
+func (e *DataStoreEntry) Decode(v interface{}) error {
+	return json.Unmarshal(e.Value, v)
+}
+
+// EntryOptions holds the optional fields sent when writing a data store entry.
+type EntryOptions struct {
+	// UserIDs are the IDs of the users to associate with the entry.
+	UserIDs []string
+
+	// Attributes are custom attributes to store alongside the entry.
+	Attributes map[string]interface{}
+
+	// Etag, when set on an update, makes the write fail with ErrPreconditionFailed
+	// or ErrConflict unless the entry's current etag matches.
+	Etag string
+
+	// AllowMissing, when set on an update, creates the entry if it does not exist.
+	AllowMissing bool
+}
+
+// body returns the request body for writing an entry with the provided options.
+func (o EntryOptions) body() map[string]interface{} {
+	body := map[string]interface{}{}
+	if o.UserIDs != nil {
+		users := make([]string, 0, len(o.UserIDs))
+		for _, userID := range o.UserIDs {
+			users = append(users, "users/"+userID)
+		}
+		body["users"] = users
+	}
+	if o.Attributes != nil {
+		body["attributes"] = o.Attributes
+	}
+	if o.Etag != "" {
+		body["etag"] = o.Etag
+	}
+
+	return body
+}
+
+// ListEntriesOptions controls how ListEntries fetches the keys of a data store.
+type ListEntriesOptions struct {
+	PageOptions
+
+	// Prefix limits the entries returned to keys starting with the prefix.
+	Prefix string
+
+	// ShowDeleted includes deleted entries that still have revisions.
+	ShowDeleted bool
+}
+
+// DataStore returns a handle to the standard data store with the provided name and scope.
+// An empty scope uses the default "global" scope.
+//
+// No request is made until one of the handle's methods is called.
+func (u *Universe) DataStore(name string, scope string) *DataStore {
+	return &DataStore{
+		Name:     name,
+		Scope:    scope,
+		Universe: u,
+	}
+}
+
+// entriesURL returns the URL of the data store's entries collection, followed by the provided suffix.
+func (d *DataStore) entriesURL(suffix string) string {
+	path := "/data-stores/" + escapePathSegment(d.Name)
+	if d.Scope != "" {
+		path += "/scopes/" + escapePathSegment(d.Scope)
+	}
+
+	return d.Universe.Client.cloudEndpoint(d.Universe.cloudPath(path + "/entries" + suffix))
+}
+
+// entryURL returns the URL of a single entry, followed by the provided suffix.
+func (d *DataStore) entryURL(key string, suffix string) string {
+	return d.entriesURL("/" + escapePathSegment(key) + suffix)
+}
+
+// validate checks that the data store handle and entry key are usable.
+func (d *DataStore) validate(key string) error {
+	if d.Universe.ID == "" {
+		return ErrNoUniverseID
+	}
+	if d.Name == "" {
+		return ErrNoDataStoreName
+	}
+	if key == "" {
+		return ErrNoEntryKey
+	}
+
+	return nil
+}
+
+// GetEntry retrieves the current revision of a data store entry.
+//
+// Returns ErrNotFound (through errors.Is) if the entry does not exist.
+// Returns an error if the key is empty, the HTTP request fails, or the response cannot be decoded.
+func (d *DataStore) GetEntry(key string) (*DataStoreEntry, error) {
+	return d.GetEntryContext(context.Background(), key)
+}
+
+// GetEntryContext is like GetEntry but uses the provided context for the request.
+func (d *DataStore) GetEntryContext(ctx context.Context, key string) (*DataStoreEntry, error) {
+	if err := d.validate(key); err != nil {
+		return nil, err
+	}
+
+	resp, err := d.Universe.Client.get(ctx, d.entryURL(key, ""), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	entry := &DataStoreEntry{}
+	err = json.NewDecoder(resp.Body).Decode(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// CreateEntry creates a new data store entry with the provided JSON serializable value.
+//
+// Returns ErrConflict (through errors.Is) if the entry already exists.
+// Returns an error if the key is empty, the HTTP request fails, or the response cannot be decoded.
+func (d *DataStore) CreateEntry(key string, value interface{}, opts EntryOptions) (*DataStoreEntry, error) {
+	return d.CreateEntryContext(context.Background(), key, value, opts)
+}
+
+// CreateEntryContext is like CreateEntry but uses the provided context for the request.
+func (d *DataStore) CreateEntryContext(ctx context.Context, key string, value interface{}, opts EntryOptions) (*DataStoreEntry, error) {
+	if err := d.validate(key); err != nil {
+		return nil, err
+	}
+
+	requestBody := opts.body()
+	requestBody["value"] = value
+	resp, err := d.Universe.Client.post(ctx, d.entriesURL(""), requestBody, nil, []queryParam{{Key: "id", Value: key}})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	entry := &DataStoreEntry{}
+	err = json.NewDecoder(resp.Body).Decode(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// UpdateEntry replaces the value of a data store entry with the provided JSON serializable value.
+//
+// Set EntryOptions.Etag to only update the entry if it has not changed since it was read,
+// and EntryOptions.AllowMissing to create the entry if it does not exist.
+// Returns an error if the key is empty, a precondition fails, the HTTP request fails,
+// or the response cannot be decoded.
+func (d *DataStore) UpdateEntry(key string, value interface{}, opts EntryOptions) (*DataStoreEntry, error) {
+	return d.UpdateEntryContext(context.Background(), key, value, opts)
+}
+
+// UpdateEntryContext is like UpdateEntry but uses the provided context for the request.
+func (d *DataStore) UpdateEntryContext(ctx context.Context, key string, value interface{}, opts EntryOptions) (*DataStoreEntry, error) {
+	if err := d.validate(key); err != nil {
+		return nil, err
+	}
+
+	requestBody := opts.body()
+	requestBody["value"] = value
+	var query []queryParam
+	if opts.AllowMissing {
+		query = append(query, queryParam{Key: "allowMissing", Value: "true"})
+	}
+	resp, err := d.Universe.Client.patch(ctx, d.entryURL(key, ""), requestBody, nil, query)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	entry := &DataStoreEntry{}
+	err = json.NewDecoder(resp.Body).Decode(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// IncrementEntry atomically adds amount to the numeric value of a data store entry,
+// creating the entry if it does not exist.
+//
+// Only the UserIDs and Attributes of the options are used.
+// Returns an error if the key is empty, the entry's value is not numeric,
+// the HTTP request fails, or the response cannot be decoded.
+func (d *DataStore) IncrementEntry(key string, amount float64, opts EntryOptions) (*DataStoreEntry, error) {
+	return d.IncrementEntryContext(context.Background(), key, amount, opts)
+}
+
+// IncrementEntryContext is like IncrementEntry but uses the provided context for the request.
+func (d *DataStore) IncrementEntryContext(ctx context.Context, key string, amount float64, opts EntryOptions) (*DataStoreEntry, error) {
+	if err := d.validate(key); err != nil {
+		return nil, err
+	}
+
+	opts.Etag = ""
+	requestBody := opts.body()
+	requestBody["amount"] = amount
+	resp, err := d.Universe.Client.post(ctx, d.entryURL(key, ":increment"), requestBody, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	entry := &DataStoreEntry{}
+	err = json.NewDecoder(resp.Body).Decode(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// DeleteEntry marks a data store entry as deleted. Its revisions are kept
+// by Roblox for a limited time before the entry is permanently removed.
+//
+// Returns true if the entry was successfully deleted.
+// Returns an error if the key is empty or the HTTP request fails.
+func (d *DataStore) DeleteEntry(key string) (bool, error) {
+	return d.DeleteEntryContext(context.Background(), key)
+}
+
+// DeleteEntryContext is like DeleteEntry but uses the provided context for the request.
+func (d *DataStore) DeleteEntryContext(ctx context.Context, key string) (bool, error) {
+	if err := d.validate(key); err != nil {
+		return false, err
+	}
+
+	return d.Universe.Client.delete(ctx, d.entryURL(key, ""), nil)
+}
+
+// ListEntries returns a Pager over the entries of the data store, optionally limited to keys
+// starting with a prefix. Pages are only fetched as the caller iterates.
+//
+// Listed entries only identify the entry; use GetEntry to read an entry's value.
+func (d *DataStore) ListEntries(opts ListEntriesOptions) *Pager[DataStoreEntry] {
+	var query []queryParam
+	if opts.Prefix != "" {
+		query = append(query, queryParam{Key: "filter", Value: "id.startsWith(" + strconv.Quote(opts.Prefix) + ")"})
+	}
+	if opts.ShowDeleted {
+		query = append(query, queryParam{Key: "showDeleted", Value: "true"})
+	}
+
+	return newPager[DataStoreEntry](d.Universe.Client, d.entriesURL(""), "dataStoreEntries", query, opts.PageOptions)
+}
+
+// escapePathSegment escapes a user provided name or key for use as a single segment of
+// an Open Cloud resource path, including the characters used for custom methods and revisions.
+func escapePathSegment(segment string) string {
+	segment = url.PathEscape(segment)
+	segment = strings.ReplaceAll(segment, ":", "%3A")
+
+	return strings.ReplaceAll(segment, "@", "%40")
+}
+
+// RestoreOptions controls how RestoreAt restores a data store entry.
+type RestoreOptions struct {
+	// DryRun finds the revision that would be restored without writing it.
+	DryRun bool
+}
+
+// ListRevisions returns a Pager over the revisions of a data store entry, newest first.
+// Pages are only fetched as the caller iterates.
+//
+// Listed revisions only identify the revision; use GetRevision to read a revision's value.
+func (d *DataStore) ListRevisions(key string, opts PageOptions) *Pager[DataStoreEntry] {
+	return newPager[DataStoreEntry](d.Universe.Client, d.entryURL(key, ":listRevisions"), "dataStoreEntries", nil, opts)
+}
+
+// GetRevision retrieves a specific revision of a data store entry.
+//
+// Returns ErrNotFound (through errors.Is) if the revision does not exist.
+// Returns an error if the key or revision ID is empty, the HTTP request fails,
+// or the response cannot be decoded.
+func (d *DataStore) GetRevision(key string, revisionID string) (*DataStoreEntry, error) {
+	return d.GetRevisionContext(context.Background(), key, revisionID)
+}
+
+// GetRevisionContext is like GetRevision but uses the provided context for the request.
+func (d *DataStore) GetRevisionContext(ctx context.Context, key string, revisionID string) (*DataStoreEntry, error) {
+	if err := d.validate(key); err != nil {
+		return nil, err
+	}
+	if revisionID == "" {
+		return nil, ErrNoRevisionID
+	}
+
+	resp, err := d.Universe.Client.get(ctx, d.entryURL(key, "@"+escapePathSegment(revisionID)), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	entry := &DataStoreEntry{}
+	err = json.NewDecoder(resp.Body).Decode(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// RestoreAt restores a data store entry to the value it held at the provided time.
+//
+// It finds the newest revision created at or before the time and writes its value,
+// users and attributes back as the current value of the entry, returning the new entry.
+// If that revision is a deletion, the entry is deleted instead and the deleted revision
+// is returned, with its State set to "DELETED".
+// With RestoreOptions.DryRun set, nothing is written and the revision that would
+// be restored is returned instead.
+//
+// Returns ErrNoRevision if the entry has no revision at or before the time.
+// Returns an error if any request fails or a response cannot be decoded.
+func (d *DataStore) RestoreAt(key string, at time.Time, opts RestoreOptions) (*DataStoreEntry, error) {
+	return d.RestoreAtContext(context.Background(), key, at, opts)
+}
+
+// RestoreAtContext is like RestoreAt but uses the provided context for every request.
+func (d *DataStore) RestoreAtContext(ctx context.Context, key string, at time.Time, opts RestoreOptions) (*DataStoreEntry, error) {
+	if err := d.validate(key); err != nil {
+		return nil, err
+	}
+
+	// Revisions are listed newest first, so the listing stops at the first
+	// revision older than the best match found so far.
+	var newest *DataStoreEntry
+	pager := d.ListRevisions(key, PageOptions{PageSize: 100})
+	for {
+		revision, err := pager.Next(ctx)
+		if err == ErrNoMorePages {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if revision.RevisionCreatedAt.After(at) {
+			continue
+		}
+		if newest != nil && revision.RevisionCreatedAt.Before(newest.RevisionCreatedAt) {
+			break
+		}
+		if newest == nil || revision.RevisionCreatedAt.After(newest.RevisionCreatedAt) {
+			newest = &revision
+		}
+	}
+	if newest == nil {
+		return nil, ErrNoRevision
+	}
+
+	if newest.State == entryStateDeleted {
+		if opts.DryRun {
+			return newest, nil
+		}
+		_, err := d.DeleteEntryContext(ctx, key)
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			return nil, err
+		}
+		return newest, nil
+	}
+
+	revision, err := d.GetRevisionContext(ctx, key, newest.RevisionID)
+	if err != nil {
+		return nil, err
+	}
+	if opts.DryRun {
+		return revision, nil
+	}
+
+	// Users and attributes are always sent, so that ones added after the revision are cleared.
+	restore := EntryOptions{
+		UserIDs:      append([]string{}, revision.UserIDs...),
+		Attributes:   revision.Attributes,
+		AllowMissing: true,
+	}
+	if restore.Attributes == nil {
+		restore.Attributes = map[string]interface{}{}
+	}
+
+	return d.UpdateEntryContext(ctx, key, revision.Value, restore)
+}
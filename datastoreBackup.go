@@ -0,0 +1,244 @@
+package robloxgo
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// DataStoreRecord is a single line of a data store NDJSON export, as written by
+// Export and read by Import.
+type DataStoreRecord struct {
+	// Key is the key of the entry.
+	Key string `json:"key"`
+
+	// Scope is the scope of the entry.
+	Scope string `json:"scope"`
+
+	// Value is the JSON encoded value of the entry.
+	Value json.RawMessage `json:"value"`
+
+	// Attributes are the custom attributes of the entry.
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+
+	// UserIDs are the IDs of the users associated with the entry.
+	UserIDs []string `json:"userIds,omitempty"`
+
+	// RevisionID is the revision of the entry at the time it was exported.
+	RevisionID string `json:"revisionId,omitempty"`
+}
+
+// ImportOptions controls how Import writes entries.
+type ImportOptions struct {
+	// Concurrency is the number of entries written at once. A value of 0 defaults to 4.
+	Concurrency int
+
+	// ResumeFromLine is the 1-based line of the input to start importing from,
+	// typically the NextLine of a previous, interrupted import. Earlier lines are skipped.
+	ResumeFromLine int
+
+	// SkipIfExists leaves entries that already exist untouched instead of overwriting them.
+	SkipIfExists bool
+}
+
+// ImportResult reports the progress of an Import.
+type ImportResult struct {
+	// Imported is the number of entries written.
+	Imported int
+
+	// Skipped is the number of entries left untouched because they already existed.
+	Skipped int
+
+	// NextLine is the first line that was not fully processed. Pass it as
+	// ImportOptions.ResumeFromLine to resume an interrupted import.
+	NextLine int
+}
+
+// maxRecordSize is the longest NDJSON line Import accepts, leaving room for
+// a 4MB data store value plus its encoding overhead and metadata.
+const maxRecordSize = 16 << 20
+
+// Export writes every entry of the data store to w as NDJSON, one DataStoreRecord per line.
+//
+// Entries are listed and then read one by one through the client, so the export shares the
+// client's rate limiter and retry policy. Entries deleted while the export runs are skipped.
+// Returns the number of entries written, and an error if any request or write fails.
+func (d *DataStore) Export(ctx context.Context, w io.Writer) (int, error) {
+	scope := d.Scope
+	if scope == "" {
+		scope = "global"
+	}
+
+	encoder := json.NewEncoder(w)
+	var exported int
+	err := d.ListEntries(ListEntriesOptions{PageOptions: PageOptions{PageSize: 256}}).Each(ctx, func(listed DataStoreEntry) error {
+		entry, err := d.GetEntryContext(ctx, listed.Key)
+		if errors.Is(err, ErrNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		err = encoder.Encode(DataStoreRecord{
+			Key:        entry.Key,
+			Scope:      scope,
+			Value:      entry.Value,
+			Attributes: entry.Attributes,
+			UserIDs:    entry.UserIDs,
+			RevisionID: entry.RevisionID,
+		})
+		if err != nil {
+			return err
+		}
+		exported++
+		return nil
+	})
+
+	return exported, err
+}
+
+// Import reads NDJSON DataStoreRecords from r and writes them to the data store, keeping
+// each record's scope. Entries are written concurrently through the client's rate limiter
+// and retry policy.
+//
+// The import stops at the first error. The returned ImportResult is always set, and its
+// NextLine can be passed as ImportOptions.ResumeFromLine to resume from where it stopped.
+func (d *DataStore) Import(ctx context.Context, r io.Reader, opts ImportOptions) (*ImportResult, error) {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = 4
+	}
+	startLine := opts.ResumeFromLine
+	if startLine < 1 {
+		startLine = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type importJob struct {
+		line   int
+		record DataStoreRecord
+	}
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		firstErr error
+		done     = map[int]bool{}
+	)
+	result := &ImportResult{NextLine: startLine}
+
+	// finish marks a line as processed and advances NextLine past every contiguous processed line.
+	finish := func(line int, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+				cancel()
+			}
+			return
+		}
+		done[line] = true
+		for done[result.NextLine] {
+			delete(done, result.NextLine)
+			result.NextLine++
+		}
+	}
+
+	jobs := make(chan importJob)
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				skipped, err := d.importRecord(ctx, job.record, opts.SkipIfExists)
+				if err != nil {
+					err = fmt.Errorf("line %d (key %q): %w", job.line, job.record.Key, err)
+				}
+				mu.Lock()
+				if err == nil && skipped {
+					result.Skipped++
+				} else if err == nil {
+					result.Imported++
+				}
+				mu.Unlock()
+				finish(job.line, err)
+			}
+		}()
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxRecordSize)
+	var readErr error
+	for line := 1; scanner.Scan(); line++ {
+		if line < startLine {
+			continue
+		}
+		if len(scanner.Bytes()) == 0 {
+			finish(line, nil)
+			continue
+		}
+
+		var record DataStoreRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			readErr = fmt.Errorf("line %d: %w", line, err)
+			break
+		}
+
+		select {
+		case jobs <- importJob{line: line, record: record}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	if readErr == nil {
+		readErr = scanner.Err()
+	}
+	close(jobs)
+	wg.Wait()
+
+	if firstErr != nil {
+		return result, firstErr
+	}
+	if readErr != nil {
+		return result, readErr
+	}
+
+	return result, ctx.Err()
+}
+
+// importRecord writes a single record into the scope it was exported from.
+// It reports whether the record was skipped because the entry already existed.
+func (d *DataStore) importRecord(ctx context.Context, record DataStoreRecord, skipIfExists bool) (bool, error) {
+	store := d
+	if record.Scope != "" && record.Scope != d.Scope {
+		store = d.Universe.DataStore(d.Name, record.Scope)
+	}
+
+	opts := EntryOptions{
+		UserIDs:    record.UserIDs,
+		Attributes: record.Attributes,
+	}
+	if skipIfExists {
+		_, err := store.CreateEntryContext(ctx, record.Key, record.Value, opts)
+		if errors.Is(err, ErrConflict) {
+			return true, nil
+		}
+		return false, err
+	}
+
+	opts.AllowMissing = true
+	_, err := store.UpdateEntryContext(ctx, record.Key, record.Value, opts)
+
+	return false, err
+}
@@ -0,0 +1,110 @@
+package robloxgo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeDataStore is an in-memory stand-in for the Open Cloud data store entries API of universe 1.
+type fakeDataStore struct {
+	mu      sync.Mutex
+	entries map[string]json.RawMessage
+}
+
+func (f *fakeDataStore) handler(t *testing.T) http.HandlerFunc {
+	const prefix = "/cloud/v2/universes/1/data-stores/Players/scopes/global/entries"
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		key := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, prefix), "/")
+		switch {
+		case r.Method == http.MethodGet && key == "":
+			var listed []map[string]string
+			for key := range f.entries {
+				listed = append(listed, map[string]string{"id": key})
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"dataStoreEntries": listed})
+		case r.Method == http.MethodGet:
+			json.NewEncoder(w).Encode(map[string]interface{}{"id": key, "value": f.entries[key], "revisionId": "r1", "users": []string{"users/42"}})
+		case r.Method == http.MethodPost:
+			key = r.URL.Query().Get("id")
+			if _, ok := f.entries[key]; ok {
+				w.WriteHeader(http.StatusConflict)
+				return
+			}
+			fallthrough
+		case r.Method == http.MethodPatch:
+			var body struct {
+				Value json.RawMessage `json:"value"`
+			}
+			json.NewDecoder(r.Body).Decode(&body)
+			f.entries[key] = body.Value
+			json.NewEncoder(w).Encode(map[string]interface{}{"id": key, "value": body.Value})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}
+}
+
+func TestDataStore_ExportImport(t *testing.T) {
+	source := &fakeDataStore{entries: map[string]json.RawMessage{
+		"a": json.RawMessage(`1`),
+		"b": json.RawMessage(`{"coins":2}`),
+	}}
+	client := newTestClient(t, source.handler(t))
+
+	var backup bytes.Buffer
+	exported, err := client.Universe("1").DataStore("Players", "global").Export(context.Background(), &backup)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if exported != 2 || strings.Count(backup.String(), "\n") != 2 {
+		t.Fatalf("expected 2 lines, got %d: %q", exported, backup.String())
+	}
+
+	target := &fakeDataStore{entries: map[string]json.RawMessage{"a": json.RawMessage(`99`)}}
+	client = newTestClient(t, target.handler(t))
+	result, err := client.Universe("1").DataStore("Players", "global").Import(context.Background(), &backup, ImportOptions{SkipIfExists: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Imported != 1 || result.Skipped != 1 || result.NextLine != 3 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if string(target.entries["a"]) != "99" || string(target.entries["b"]) != `{"coins":2}` {
+		t.Fatalf("unexpected entries: %v", target.entries)
+	}
+}
+
+func TestDataStore_ImportResume(t *testing.T) {
+	target := &fakeDataStore{entries: map[string]json.RawMessage{}}
+	client := newTestClient(t, target.handler(t))
+
+	input := `{"key":"a","scope":"global","value":1}
+not json
+{"key":"c","scope":"global","value":3}
+`
+	store := client.Universe("1").DataStore("Players", "global")
+	result, err := store.Import(context.Background(), strings.NewReader(input), ImportOptions{Concurrency: 1})
+	if err == nil {
+		t.Fatal("expected error for invalid line, got nil")
+	}
+	if result.NextLine != 2 {
+		t.Fatalf("expected to stop at line 2, got %+v", result)
+	}
+
+	result, err = store.Import(context.Background(), strings.NewReader(input), ImportOptions{ResumeFromLine: 3})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Imported != 1 || len(target.entries) != 2 {
+		t.Fatalf("unexpected result %+v with entries %v", result, target.entries)
+	}
+}
@@ -0,0 +1,175 @@
+package robloxgo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestDataStore_GetEntry(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != "/cloud/v2/universes/1/data-stores/Players/scopes/global/entries/player%3A1" {
+			t.Errorf("unexpected path %q", r.URL.EscapedPath())
+		}
+		w.Write([]byte(`{"path":"universes/1/data-stores/Players/scopes/global/entries/player:1","id":"player:1",
+			"value":{"coins":10},"etag":"e1","revisionId":"r1","state":"ACTIVE",
+			"users":["users/42"],"attributes":{"version":2}}`))
+	})
+
+	entry, err := client.Universe("1").DataStore("Players", "global").GetEntry("player:1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if entry.Key != "player:1" || entry.Etag != "e1" || len(entry.UserIDs) != 1 || entry.UserIDs[0] != "42" {
+		t.Fatalf("unexpected entry: %+v", entry)
+	}
+	if entry.Attributes["version"].(float64) != 2 {
+		t.Fatalf("unexpected attributes: %v", entry.Attributes)
+	}
+
+	var value struct {
+		Coins int `json:"coins"`
+	}
+	if err := entry.Decode(&value); err != nil || value.Coins != 10 {
+		t.Fatalf("unexpected value %+v: %v", value, err)
+	}
+}
+
+func TestDataStore_UpdateEntryPrecondition(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Query().Get("allowMissing") != "true" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["etag"] != "stale" || body["users"].([]interface{})[0] != "users/42" {
+			t.Errorf("unexpected body %v", body)
+		}
+		w.WriteHeader(http.StatusPreconditionFailed)
+		w.Write([]byte(`{"code":"FAILED_PRECONDITION","message":"etag mismatch"}`))
+	})
+
+	store := client.Universe("1").DataStore("Players", "")
+	_, err := store.UpdateEntry("player", 5, EntryOptions{Etag: "stale", UserIDs: []string{"42"}, AllowMissing: true})
+	if !errors.Is(err, ErrPreconditionFailed) {
+		t.Fatalf("expected ErrPreconditionFailed, got %v", err)
+	}
+}
+
+func TestDataStore_ListEntriesPrefix(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/cloud/v2/universes/1/data-stores/Players/entries" {
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+		if filter := r.URL.Query().Get("filter"); filter != `id.startsWith("player:")` {
+			t.Errorf("unexpected filter %q", filter)
+		}
+		io.WriteString(w, `{"dataStoreEntries":[{"id":"player:1"},{"id":"player:2"}]}`)
+	})
+
+	entries, err := client.Universe("1").DataStore("Players", "").ListEntries(ListEntriesOptions{Prefix: "player:"}).All(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(entries) != 2 || entries[1].Key != "player:2" {
+		t.Fatalf("unexpected entries: %+v", entries)
+	}
+}
+
+// revisionsHandler serves three revisions of the entry "player", recording the body of any update.
+func revisionsHandler(t *testing.T, updated *map[string]interface{}) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.EscapedPath() {
+		case "/cloud/v2/universes/1/data-stores/Players/entries/player:listRevisions":
+			io.WriteString(w, `{"dataStoreEntries":[
+				{"id":"player","revisionId":"r3","revisionCreateTime":"2025-03-01T00:00:00Z"},
+				{"id":"player","revisionId":"r2","revisionCreateTime":"2025-02-01T00:00:00Z"},
+				{"id":"player","revisionId":"r1","revisionCreateTime":"2025-01-01T00:00:00Z"}
+			]}`)
+		case "/cloud/v2/universes/1/data-stores/Players/entries/player@r2":
+			io.WriteString(w, `{"id":"player","revisionId":"r2","value":{"coins":20},"users":["users/42"]}`)
+		case "/cloud/v2/universes/1/data-stores/Players/entries/player":
+			if r.Method != http.MethodPatch {
+				t.Errorf("unexpected method %s", r.Method)
+			}
+			json.NewDecoder(r.Body).Decode(updated)
+			io.WriteString(w, `{"id":"player","revisionId":"r4","value":{"coins":20}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.EscapedPath())
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+}
+
+func TestDataStore_RestoreAt(t *testing.T) {
+	var updated map[string]interface{}
+	client := newTestClient(t, revisionsHandler(t, &updated))
+	store := client.Universe("1").DataStore("Players", "")
+	at := time.Date(2025, 2, 15, 0, 0, 0, 0, time.UTC)
+
+	revision, err := store.RestoreAt("player", at, RestoreOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if revision.RevisionID != "r2" || updated != nil {
+		t.Fatalf("expected dry run of r2 without writing, got %+v", revision)
+	}
+
+	entry, err := store.RestoreAt("player", at, RestoreOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if entry.RevisionID != "r4" || updated["value"].(map[string]interface{})["coins"].(float64) != 20 {
+		t.Fatalf("unexpected restore %+v with body %v", entry, updated)
+	}
+
+	_, err = store.RestoreAt("player", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), RestoreOptions{})
+	if err != ErrNoRevision {
+		t.Fatalf("expected ErrNoRevision, got %v", err)
+	}
+}
+
+func TestDataStore_RestoreAtDeletedRevision(t *testing.T) {
+	var deletes int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.EscapedPath() {
+		case "GET /cloud/v2/universes/1/data-stores/Players/entries/player:listRevisions":
+			if r.URL.Query().Get("pageToken") != "" {
+				t.Errorf("expected the listing to stop before the older revisions")
+			}
+			io.WriteString(w, `{"dataStoreEntries":[
+				{"id":"player","revisionId":"r3","revisionCreateTime":"2025-03-01T00:00:00Z"},
+				{"id":"player","revisionId":"r2","revisionCreateTime":"2025-02-01T00:00:00Z","state":"DELETED"},
+				{"id":"player","revisionId":"r1","revisionCreateTime":"2025-01-01T00:00:00Z"}
+			],"nextPageToken":"older"}`)
+		case "DELETE /cloud/v2/universes/1/data-stores/Players/entries/player":
+			deletes++
+			io.WriteString(w, `{}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.EscapedPath())
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	store := client.Universe("1").DataStore("Players", "")
+	at := time.Date(2025, 2, 15, 0, 0, 0, 0, time.UTC)
+
+	revision, err := store.RestoreAt("player", at, RestoreOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if revision.RevisionID != "r2" || revision.State != "DELETED" || deletes != 0 {
+		t.Fatalf("expected dry run of the r2 deletion without writing, got %+v", revision)
+	}
+
+	revision, err = store.RestoreAt("player", at, RestoreOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if revision.State != "DELETED" || deletes != 1 {
+		t.Fatalf("expected the entry to be deleted, got %+v after %d deletes", revision, deletes)
+	}
+}
@@ -1,27 +1,76 @@
 package robloxgo
 
+import "strings"
+
 // CloudAPIVersion is the Opencloud API version used for REST and Websocket API.
 var (
 	CloudAPIVersion = "2"
 )
 
 // Roblox API Endpoints
-// These are used internally to build request paths for the
-// various functions that this package provides
+// These are the default base URLs of a Client, and can be overridden
+// per API family using WithBaseURL
 var (
 	EndpointRoblox = "https://roblox.com"
 
 	// Cloud APIs
-	EndpointCloud       = "https://apis.roblox.com/cloud/v"
+	EndpointApis        = "https://apis.roblox.com"
+	EndpointCloud       = EndpointApis + "/cloud/v"
 	EndpointCloudAPI    = EndpointCloud + CloudAPIVersion + "/"
 	EndPointCloudUsers  = EndpointCloudAPI + "users/"
 	EndpointCloudGroups = EndpointCloudAPI + "groups/"
 
 	// Legacy APIs
 	EndpointLegacyUsers        = "https://users.roblox.com"
-	EndpointLegacyGetUsers     = EndpointLegacyUsers + "/v1/usernames/users"
+	EndpointLegacyGetUsers     = EndpointLegacyUsers + pathLegacyGetUsers
 	EndpointLegacyGroups       = "https://groups.roblox.com"
-	EndpointLegacyGetGroups    = EndpointLegacyGroups + "/v1/groups/search/lookup"
+	EndpointLegacyGetGroups    = EndpointLegacyGroups + pathLegacyGetGroups
 	EndpointLegacyThumbnails   = "https://thumbnails.roblox.com"
-	EndpointLegacyGetGroupIcon = EndpointLegacyThumbnails + "/v1/groups/icons"
+	EndpointLegacyGetGroupIcon = EndpointLegacyThumbnails + pathLegacyGetGroupIcon
+)
+
+// Request paths, relative to the base URL of their API family
+const (
+	pathLegacyGetUsers     = "/v1/usernames/users"
+	pathLegacyGetUsersByID = "/v1/users"
+	pathLegacyGetGroups    = "/v1/groups/search/lookup"
+	pathLegacyGetGroupIcon = "/v1/groups/icons"
+)
+
+// APIFamily identifies a group of Roblox APIs served from the same base URL.
+type APIFamily string
+
+// API families whose base URL can be set with WithBaseURL.
+const (
+	// FamilyCloud covers https://apis.roblox.com, which serves Open Cloud.
+	FamilyCloud APIFamily = "cloud"
+
+	// FamilyUsers covers the legacy https://users.roblox.com API.
+	FamilyUsers APIFamily = "users"
+
+	// FamilyGroups covers the legacy https://groups.roblox.com API.
+	FamilyGroups APIFamily = "groups"
+
+	// FamilyThumbnails covers the legacy https://thumbnails.roblox.com API.
+	FamilyThumbnails APIFamily = "thumbnails"
 )
+
+// defaultBaseURLs returns the base URL of every API family, built from the package endpoints.
+func defaultBaseURLs() map[APIFamily]string {
+	return map[APIFamily]string{
+		FamilyCloud:      EndpointApis,
+		FamilyUsers:      EndpointLegacyUsers,
+		FamilyGroups:     EndpointLegacyGroups,
+		FamilyThumbnails: EndpointLegacyThumbnails,
+	}
+}
+
+// endpoint builds a request URL from the client's base URL for the given family and a path.
+func (c *Client) endpoint(family APIFamily, path string) string {
+	return strings.TrimSuffix(c.baseURLs[family], "/") + path
+}
+
+// cloudEndpoint builds an Open Cloud request URL, relative to /cloud/v{CloudAPIVersion}/.
+func (c *Client) cloudEndpoint(path string) string {
+	return c.endpoint(FamilyCloud, "/cloud/v"+CloudAPIVersion+"/"+path)
+}
@@ -1,6 +1,14 @@
 package robloxgo
 
-import "errors"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
 
 var (
 	ErrNoAPIKey = errors.New("no api key provided")
@@ -14,5 +22,194 @@ var (
 	ErrNoGroupname      = errors.New("no group name provided")
 	ErrInvalidGroupname = errors.New("invalid group name provided")
 
-	ErrNoRoleID = errors.New("no role id provided")
+	ErrNoRoleID          = errors.New("no role id provided")
+	ErrInvalidRoleRank   = errors.New("role rank must be between 1 and 254")
+	ErrInvalidGroupSpec  = errors.New("invalid group spec")
+	ErrPlanGroupMismatch = errors.New("plan was made for another group")
+	ErrNotAttempted      = errors.New("update was not attempted")
+	ErrUnassignableRole  = errors.New("the guest and owner roles cannot be assigned")
+	ErrUserNotFound      = errors.New("user does not exist")
+
+	ErrNoUniverseID    = errors.New("no universe id provided")
+	ErrNoDataStoreName = errors.New("no data store name provided")
+	ErrNoEntryKey      = errors.New("no entry key provided")
+	ErrNoRevisionID    = errors.New("no revision id provided")
+	ErrNoRevision      = errors.New("no revision found at or before the given time")
+
+	ErrNoMemoryStoreName = errors.New("no memory store name provided")
+	ErrNoReadID          = errors.New("no queue read id provided")
+
+	ErrNoPlaceID         = errors.New("no place id provided")
+	ErrInvalidUpdateMask = errors.New("invalid or empty update mask")
+	ErrNoScript          = errors.New("no luau script provided")
+	ErrLuauTaskCancelled = errors.New("luau task was cancelled")
+
+	ErrNoTopic         = errors.New("no messaging topic provided")
+	ErrTopicTooLong    = errors.New("messaging topic is too long")
+	ErrMessageTooLarge = errors.New("messaging message is too large")
+
+	ErrNoMorePages = errors.New("no more pages")
 )
+
+// Sentinel errors that an *APIError matches through errors.Is, based on the
+// HTTP status code and error code returned by Roblox.
+var (
+	ErrBadRequest         = errors.New("bad request")
+	ErrUnauthorized       = errors.New("unauthorized")
+	ErrPermissionDenied   = errors.New("permission denied")
+	ErrNotFound           = errors.New("not found")
+	ErrConflict           = errors.New("conflict")
+	ErrPreconditionFailed = errors.New("precondition failed")
+	ErrRateLimited        = errors.New("rate limited")
+	ErrServerError        = errors.New("server error")
+)
+
+// APIError is returned when a Roblox API responds with a non-successful status code.
+//
+// Both the legacy error body ({"errors": [{"code", "message"}]}) and the Open Cloud
+// error body ({"code", "message", "details"}) are parsed into the same fields.
+// Use errors.Is with the sentinel errors above to check for a class of failure,
+// or errors.As to inspect the full response.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+
+	// Code is the error code reported by the API, such as "NOT_FOUND" for Open Cloud
+	// or the numeric code of the first error for legacy endpoints.
+	Code string
+
+	// Message is the human readable error message reported by the API.
+	Message string
+
+	// Details holds any additional error details reported by the API, left undecoded.
+	Details []json.RawMessage
+
+	// Method is the HTTP method of the failed request.
+	Method string
+
+	// URL is the URL of the failed request.
+	URL string
+
+	// RetryAfter is the delay requested by the Retry-After header, or zero if none was sent.
+	RetryAfter time.Duration
+
+	// Body is the raw response body.
+	Body []byte
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("http error %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	if e.Code != "" {
+		msg += " (" + e.Code + ")"
+	}
+	if e.Method != "" || e.URL != "" {
+		msg += " " + strings.TrimSpace(e.Method+" "+e.URL)
+	}
+	if e.Message != "" {
+		msg += ": " + e.Message
+	} else if len(e.Body) > 0 {
+		msg += ": " + string(e.Body)
+	}
+
+	return msg
+}
+
+// Is reports whether the APIError matches one of the package sentinel errors.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrBadRequest:
+		return e.StatusCode == http.StatusBadRequest || e.Code == "INVALID_ARGUMENT"
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized || e.Code == "UNAUTHENTICATED"
+	case ErrPermissionDenied:
+		return e.StatusCode == http.StatusForbidden || e.Code == "PERMISSION_DENIED" || e.Code == "INSUFFICIENT_SCOPE"
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound || e.Code == "NOT_FOUND"
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict || e.Code == "ALREADY_EXISTS" || e.Code == "ABORTED"
+	case ErrPreconditionFailed:
+		return e.StatusCode == http.StatusPreconditionFailed || e.Code == "FAILED_PRECONDITION"
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests || e.Code == "RESOURCE_EXHAUSTED"
+	case ErrServerError:
+		return e.StatusCode >= http.StatusInternalServerError
+	}
+
+	return false
+}
+
+// newAPIError builds an APIError from a failed response and its already read body.
+func newAPIError(resp *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+		Body:       body,
+	}
+	if resp.Request != nil {
+		apiErr.Method = resp.Request.Method
+		apiErr.URL = resp.Request.URL.String()
+	}
+
+	var errorBody struct {
+		// Open Cloud v2
+		Code    json.RawMessage   `json:"code"`
+		Message string            `json:"message"`
+		Details []json.RawMessage `json:"details"`
+
+		// Open Cloud v1
+		Error        string            `json:"error"`
+		ErrorDetails []json.RawMessage `json:"errorDetails"`
+
+		// Legacy
+		Errors []struct {
+			Code    json.Number `json:"code"`
+			Message string      `json:"message"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &errorBody); err != nil {
+		return apiErr
+	}
+
+	switch {
+	case len(errorBody.Errors) > 0:
+		apiErr.Code = errorBody.Errors[0].Code.String()
+		apiErr.Message = errorBody.Errors[0].Message
+		for _, legacyErr := range errorBody.Errors {
+			detail, _ := json.Marshal(legacyErr)
+			apiErr.Details = append(apiErr.Details, detail)
+		}
+	case errorBody.Error != "":
+		apiErr.Code = errorBody.Error
+		apiErr.Message = errorBody.Message
+		apiErr.Details = errorBody.ErrorDetails
+	case len(errorBody.Code) > 0 && string(errorBody.Code) != "null":
+		apiErr.Code = strings.Trim(string(errorBody.Code), `"`)
+		apiErr.Message = errorBody.Message
+		apiErr.Details = errorBody.Details
+	}
+
+	return apiErr
+}
+
+// parseRetryAfter parses a Retry-After header value given either in seconds or as an HTTP date.
+//
+// Returns zero if the header is empty, invalid, or already in the past.
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(strings.TrimSpace(value)); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if wait := time.Until(date); wait > 0 {
+			return wait
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,86 @@
+package robloxgo
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newErrorResponse(statusCode int, body string, header http.Header) *http.Response {
+	req, _ := http.NewRequest(http.MethodGet, "https://apis.roblox.com/cloud/v2/users/1", nil)
+	if header == nil {
+		header = http.Header{}
+	}
+
+	return &http.Response{
+		StatusCode: statusCode,
+		Header:     header,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}
+}
+
+func TestHttpErrorCheck_OpenCloudBody(t *testing.T) {
+	resp := newErrorResponse(http.StatusNotFound, `{"code":"NOT_FOUND","message":"User not found","details":[{"reason":"x"}]}`, nil)
+
+	err := httpErrorCheck(resp)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *APIError, got %T", err)
+	}
+	if apiErr.Code != "NOT_FOUND" || apiErr.Message != "User not found" || len(apiErr.Details) != 1 {
+		t.Fatalf("unexpected error fields: %+v", apiErr)
+	}
+	if apiErr.Method != http.MethodGet || apiErr.URL == "" {
+		t.Fatalf("expected request method and url, got %q %q", apiErr.Method, apiErr.URL)
+	}
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatal("expected error to match ErrNotFound")
+	}
+	if errors.Is(err, ErrPermissionDenied) {
+		t.Fatal("did not expect error to match ErrPermissionDenied")
+	}
+}
+
+func TestHttpErrorCheck_LegacyBody(t *testing.T) {
+	resp := newErrorResponse(http.StatusForbidden, `{"errors":[{"code":4,"message":"You do not have permission"}]}`, nil)
+
+	err := httpErrorCheck(resp)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *APIError, got %T", err)
+	}
+	if apiErr.Code != "4" || apiErr.Message != "You do not have permission" {
+		t.Fatalf("unexpected error fields: %+v", apiErr)
+	}
+	if !errors.Is(err, ErrPermissionDenied) {
+		t.Fatal("expected error to match ErrPermissionDenied")
+	}
+}
+
+func TestHttpErrorCheck_RetryAfter(t *testing.T) {
+	resp := newErrorResponse(http.StatusTooManyRequests, `not json`, http.Header{"Retry-After": []string{"3"}})
+
+	err := httpErrorCheck(resp)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *APIError, got %T", err)
+	}
+	if apiErr.RetryAfter != 3*time.Second {
+		t.Fatalf("expected 3s retry after, got %v", apiErr.RetryAfter)
+	}
+	if !errors.Is(err, ErrRateLimited) {
+		t.Fatal("expected error to match ErrRateLimited")
+	}
+}
+
+func TestHttpErrorCheck_OK(t *testing.T) {
+	resp := newErrorResponse(http.StatusOK, `{}`, nil)
+
+	if err := httpErrorCheck(resp); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
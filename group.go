@@ -1,8 +1,10 @@
 package robloxgo
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"strconv"
 	"strings"
 	"time"
@@ -62,6 +64,73 @@ type GroupMember struct {
 	GroupRole GroupRole
 }
 
+// GroupMembership represents a raw group membership as returned by the Open Cloud API.
+type GroupMembership struct {
+	// Path is the Open Cloud resource path of the membership.
+	Path string
+
+	// UserID is the unique identifier of the member.
+	UserID string
+
+	// RoleID is the unique identifier of the member's role.
+	RoleID string
+
+	// CreatedAt is the timestamp of when the user joined the group.
+	CreatedAt time.Time
+
+	// UpdatedAt is the timestamp of when the membership was last updated.
+	UpdatedAt time.Time
+
+	// Username is the Roblox username of the member.
+	// It is only set when MembershipOptions.ExpandUsernames is true.
+	Username string
+
+	// Role is the member's role within the group.
+	// It is only set when MembershipOptions.ExpandRoles is true.
+	Role *GroupRole
+}
+
+// UnmarshalJSON decodes an Open Cloud membership, trimming the resource prefixes
+// from the user and role paths.
+func (m *GroupMembership) UnmarshalJSON(data []byte) error {
+	var membership struct {
+		Path      string    `json:"path"`
+		User      string    `json:"user"`
+		Role      string    `json:"role"`
+		CreatedAt time.Time `json:"createTime"`
+		UpdatedAt time.Time `json:"updateTime"`
+	}
+	err := json.Unmarshal(data, &membership)
+	if err != nil {
+		return err
+	}
+
+	*m = GroupMembership{
+		Path:      membership.Path,
+		UserID:    strings.TrimPrefix(membership.User, "users/"),
+		RoleID:    membership.Role[strings.LastIndex(membership.Role, "/")+1:],
+		CreatedAt: membership.CreatedAt,
+		UpdatedAt: membership.UpdatedAt,
+	}
+
+	return nil
+}
+
+// MembershipOptions controls how IterMembers fetches and expands memberships.
+type MembershipOptions struct {
+	PageOptions
+
+	// Filter is an Open Cloud filter expression limiting the memberships returned,
+	// such as "user == 'users/123'" or "role == 'groups/1/roles/2'".
+	Filter string
+
+	// ExpandUsernames resolves the username of every member, in one batched request per page.
+	ExpandUsernames bool
+
+	// ExpandRoles resolves the role of every member from the group's role table.
+	ExpandRoles bool
+}
+
 // GroupRole represents a role within a Roblox group.
 type GroupRole struct {
 	// ID is the unique identifier of the role.
@@ -70,8 +139,157 @@ type GroupRole struct {
 	// Name is the display name of the role.
 	Name string `json:"displayName"`
 
+	// Description is the description of the role.
+	Description string `json:"description"`
+
 	// Rank is the hierarchical rank of the role within the group.
+	// The guest role, held by users outside the group, has rank 0.
 	Rank json.Number `json:"rank"`
+
+	// MemberCount is the number of users holding the role. It is not set for the guest role.
+	MemberCount json.Number `json:"memberCount"`
+
+	// Permissions holds what the role's members are allowed to do in the group.
+	// For the guest role, it holds what users outside the group are allowed to do.
+	// It is only populated when the API key is allowed to read role permissions.
+	Permissions GroupRolePermissions `json:"permissions"`
+}
+
+// GroupRolePermissions holds the permission flags of a group role.
+type GroupRolePermissions struct {
+	ViewWallPosts         bool `json:"viewWallPosts" yaml:"viewWallPosts"`
+	CreateWallPosts       bool `json:"createWallPosts" yaml:"createWallPosts"`
+	DeleteWallPosts       bool `json:"deleteWallPosts" yaml:"deleteWallPosts"`
+	ViewGroupShout        bool `json:"viewGroupShout" yaml:"viewGroupShout"`
+	CreateGroupShout      bool `json:"createGroupShout" yaml:"createGroupShout"`
+	ChangeRank            bool `json:"changeRank" yaml:"changeRank"`
+	AcceptRequests        bool `json:"acceptRequests" yaml:"acceptRequests"`
+	ExileMembers          bool `json:"exileMembers" yaml:"exileMembers"`
+	BanMembers            bool `json:"banMembers" yaml:"banMembers"`
+	ManageRelationships   bool `json:"manageRelationships" yaml:"manageRelationships"`
+	ViewAuditLog          bool `json:"viewAuditLog" yaml:"viewAuditLog"`
+	SpendGroupFunds       bool `json:"spendGroupFunds" yaml:"spendGroupFunds"`
+	AdvertiseGroup        bool `json:"advertiseGroup" yaml:"advertiseGroup"`
+	CreateAvatarItems     bool `json:"createAvatarItems" yaml:"createAvatarItems"`
+	ManageAvatarItems     bool `json:"manageAvatarItems" yaml:"manageAvatarItems"`
+	ManageGroupUniverses  bool `json:"manageGroupUniverses" yaml:"manageGroupUniverses"`
+	ViewUniverseAnalytics bool `json:"viewUniverseAnalytics" yaml:"viewUniverseAnalytics"`
+	CreateAPIKeys         bool `json:"createApiKeys" yaml:"createApiKeys"`
+	ManageAPIKeys         bool `json:"manageApiKeys" yaml:"manageApiKeys"`
+}
+
+// legacy returns the permissions keyed by their names in the legacy groups API.
+func (p GroupRolePermissions) legacy() map[string]bool {
+	return map[string]bool{
+		"ViewWall":                      p.ViewWallPosts,
+		"PostToWall":                    p.CreateWallPosts,
+		"DeleteFromWall":                p.DeleteWallPosts,
+		"ViewStatus":                    p.ViewGroupShout,
+		"PostToStatus":                  p.CreateGroupShout,
+		"ChangeRank":                    p.ChangeRank,
+		"InviteMembers":                 p.AcceptRequests,
+		"RemoveMembers":                 p.ExileMembers,
+		"BanMembers":                    p.BanMembers,
+		"ManageRelationships":           p.ManageRelationships,
+		"ViewAuditLogs":                 p.ViewAuditLog,
+		"SpendGroupFunds":               p.SpendGroupFunds,
+		"AdvertiseGroup":                p.AdvertiseGroup,
+		"CreateItems":                   p.CreateAvatarItems,
+		"ManageItems":                   p.ManageAvatarItems,
+		"ManageGroupGames":              p.ManageGroupUniverses,
+		"ViewAnalytics":                 p.ViewUniverseAnalytics,
+		"UseCloudAuthentication":        p.CreateAPIKeys,
+		"AdministerCloudAuthentication": p.ManageAPIKeys,
+	}
+}
+
+// IsGuest reports whether the role is the guest role, held by users outside the group.
+func (r *GroupRole) IsGuest() bool {
+	return r.Rank.String() == "0"
+}
+
+// RoleOptions holds the fields of a role created or updated with CreateRole and UpdateRole.
+//
+// UpdateRole only changes the fields that are set; an empty Name or Description or a zero Rank
+// leaves the role's current value unchanged.
+type RoleOptions struct {
+	// Name is the display name of the role.
+	Name string
+
+	// Description is the description of the role.
+	Description string
+
+	// Rank is the hierarchical rank of the role, between 1 and 254.
+	Rank int
+
+	// UsingGroupFunds pays the fee for creating a role from the group's funds instead of
+	// the Robux of the account that owns the API key. It is only used by CreateRole.
+	UsingGroupFunds bool
+}
+
+// GroupShout represents the shout posted on a Roblox group's page.
+type GroupShout struct {
+	// Content is the text of the shout. It is empty if the group has no shout.
+	Content string
+
+	// PosterID is the user ID of the user who posted the shout.
+	PosterID string
+
+	// CreatedAt is the timestamp of when the shout was first posted.
+	CreatedAt time.Time
+
+	// UpdatedAt is the timestamp of when the shout was last changed.
+	UpdatedAt time.Time
+}
+
+// UnmarshalJSON decodes a group shout from either the Open Cloud shout resource
+// or the legacy group status response.
+func (s *GroupShout) UnmarshalJSON(data []byte) error {
+	var shout struct {
+		Content    string          `json:"content"`
+		Body       string          `json:"body"`
+		Poster     json.RawMessage `json:"poster"`
+		CreateTime time.Time       `json:"createTime"`
+		UpdateTime time.Time       `json:"updateTime"`
+		Created    time.Time       `json:"created"`
+		Updated    time.Time       `json:"updated"`
+	}
+	err := json.Unmarshal(data, &shout)
+	if err != nil {
+		return err
+	}
+
+	*s = GroupShout{
+		Content:   shout.Content,
+		CreatedAt: shout.CreateTime,
+		UpdatedAt: shout.UpdateTime,
+	}
+	if s.Content == "" {
+		s.Content = shout.Body
+	}
+	if s.CreatedAt.IsZero() {
+		s.CreatedAt = shout.Created
+	}
+	if s.UpdatedAt.IsZero() {
+		s.UpdatedAt = shout.Updated
+	}
+
+	if len(shout.Poster) == 0 || string(shout.Poster) == "null" {
+		return nil
+	}
+	if shout.Poster[0] == '"' {
+		var poster string
+		err = json.Unmarshal(shout.Poster, &poster)
+		s.PosterID = strings.TrimPrefix(poster, "users/")
+		return err
+	}
+	var poster struct {
+		UserID json.Number `json:"userId"`
+	}
+	err = json.Unmarshal(shout.Poster, &poster)
+	s.PosterID = poster.UserID.String()
+
+	return err
 }
 
 // newGroup returns a new Group instance associated with the provided Client.
@@ -90,11 +308,25 @@ func newGroup(client *Client) *Group {
 // An error is returned if the group ID is empty, if the HTTP request fails,
 // if the response cannot be decoded, or if the group does not exist.
 func (c *Client) GetGroupByID(groupID string) (*Group, error) {
+	return c.GetGroupByIDContext(context.Background(), groupID)
+}
+
+// GetGroupByIDContext is like GetGroupByID but uses the provided context for the request.
+//
+// Groups are served from the client's cache when possible. Pass a context
+// created with WithoutCache to always fetch the group from the API.
+func (c *Client) GetGroupByIDContext(ctx context.Context, groupID string) (*Group, error) {
 	if groupID == "" {
 		return nil, ErrNoGroupID
 	}
 
-	resp, err := c.get(EndpointCloudGroups+groupID, nil, nil)
+	key := cacheKey(CacheGroups, groupID)
+	if cached, ok := c.cacheGet(ctx, CacheGroups, key); ok {
+		group := cached.(Group)
+		return &group, nil
+	}
+
+	resp, err := c.get(ctx, c.cloudEndpoint("groups/"+groupID), nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -106,6 +338,7 @@ func (c *Client) GetGroupByID(groupID string) (*Group, error) {
 		return nil, err
 	}
 	group.OwnerID = strings.TrimPrefix(group.OwnerID, "users/")
+	c.cacheSet(CacheGroups, key, *group)
 
 	return group, nil
 }
@@ -119,6 +352,11 @@ func (c *Client) GetGroupByID(groupID string) (*Group, error) {
 // Note: This method relies on the legacy endpoint at https://groups.roblox.com/v1/groups/search/lookup,
 // which may be deprecated or removed by Roblox in the future.
 func (c *Client) GetGroupByGroupname(groupname string) (*Group, error) {
+	return c.GetGroupByGroupnameContext(context.Background(), groupname)
+}
+
+// GetGroupByGroupnameContext is like GetGroupByGroupname but uses the provided context for both requests.
+func (c *Client) GetGroupByGroupnameContext(ctx context.Context, groupname string) (*Group, error) {
 	if groupname == "" {
 		return nil, ErrNoGroupname
 	}
@@ -127,7 +365,7 @@ func (c *Client) GetGroupByGroupname(groupname string) (*Group, error) {
 		Key:   "groupName",
 		Value: groupname,
 	}
-	resp, err := c.get(EndpointLegacyGetGroups, nil, []queryParam{groupHeader})
+	resp, err := c.get(ctx, c.endpoint(FamilyGroups, pathLegacyGetGroups), nil, []queryParam{groupHeader})
 	if err != nil {
 		return nil, err
 	}
@@ -149,7 +387,7 @@ func (c *Client) GetGroupByGroupname(groupname string) (*Group, error) {
 	}
 
 	legacyGroup := &legacyResponse.Data[0]
-	resp, err = c.get(EndpointCloudGroups+legacyGroup.ID.String(), nil, nil)
+	resp, err = c.get(ctx, c.cloudEndpoint("groups/"+legacyGroup.ID.String()), nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -174,39 +412,46 @@ func (c *Client) GetGroupByGroupname(groupname string) (*Group, error) {
 // An error is returned if the HTTP request fails or if the response cannot be decoded.
 // If an individual user lookup fails, that request is skipped and the remaining are still returned.
 func (g *Group) GetJoinRequests() (requests []JoinRequest, err error) {
-	methodURL := EndpointCloudGroups + g.ID.String() + "/join-requests"
-	resp, err := g.Client.get(methodURL, nil, nil)
-	if err != nil {
-		return requests, err
-	}
-	defer resp.Body.Close()
+	return g.GetJoinRequestsContext(context.Background())
+}
 
-	var requestData struct {
-		GroupJoinRequests []struct {
-			User      string `json:"user"`
-			CreatedAt string `json:"createTime"`
-		} `json:"groupJoinRequests"`
-	}
-	err = json.NewDecoder(resp.Body).Decode(&requestData)
-	if err != nil {
-		return requests, err
-	}
+// GetJoinRequestsContext is like GetJoinRequests but uses the provided context for every request,
+// including the per-user lookups.
+func (g *Group) GetJoinRequestsContext(ctx context.Context) (requests []JoinRequest, err error) {
+	methodURL := g.Client.cloudEndpoint("groups/" + g.ID.String() + "/join-requests")
+	pager := newPager[struct {
+		User      string `json:"user"`
+		CreatedAt string `json:"createTime"`
+	}](g.Client, methodURL, "groupJoinRequests", nil, PageOptions{PageSize: 20})
 
-	for _, request := range requestData.GroupJoinRequests {
-		userID := strings.TrimPrefix(request.User, "users/")
-		user, err := g.Client.GetUserByID(userID)
+	for {
+		page, err := pager.NextPage(ctx)
+		if err == ErrNoMorePages {
+			break
+		}
 		if err != nil {
-			continue
+			return requests, err
+		}
+
+		for _, request := range page {
+			userID := strings.TrimPrefix(request.User, "users/")
+			user, err := g.Client.GetUserByIDContext(ctx, userID)
+			if err != nil {
+				if ctx.Err() != nil {
+					return requests, ctx.Err()
+				}
+				continue
+			}
+			timestamp, _ := time.Parse(time.RFC3339, request.CreatedAt)
+			requests = append(requests, JoinRequest{
+				ID:        userID,
+				Username:  user.Username,
+				CreatedAt: timestamp.UTC(),
+			})
 		}
-		timestamp, _ := time.Parse(time.RFC3339, request.CreatedAt)
-		requests = append(requests, JoinRequest{
-			ID:        userID,
-			Username:  user.Username,
-			CreatedAt: timestamp.UTC(),
-		})
 	}
 
-	return requests, err
+	return requests, nil
 }
 
 // JoinRequestAccept approves a pending group join request for the specified user ID.
@@ -215,18 +460,23 @@ func (g *Group) GetJoinRequests() (requests []JoinRequest, err error) {
 // Returns an error if the user does not exist, the HTTP request fails,
 // or the response cannot be decoded.
 func (g *Group) JoinRequestAccept(userID string) (bool, error) {
+	return g.JoinRequestAcceptContext(context.Background(), userID)
+}
+
+// JoinRequestAcceptContext is like JoinRequestAccept but uses the provided context for both requests.
+func (g *Group) JoinRequestAcceptContext(ctx context.Context, userID string) (bool, error) {
 	if userID == "" {
 		return false, ErrNoUserID
 	}
 
-	_, err := g.Client.GetUserByID(userID)
+	_, err := g.Client.GetUserByIDContext(ctx, userID)
 	if err != nil {
 		return false, err
 	}
 
-	methodURL := EndpointCloudGroups + g.ID.String() + "/join-requests/" + userID + ":accept"
+	methodURL := g.Client.cloudEndpoint("groups/" + g.ID.String() + "/join-requests/" + userID + ":accept")
 	requestBody := map[string]interface{}{}
-	resp, err := g.Client.post(methodURL, requestBody, nil, nil)
+	resp, err := g.Client.post(ctx, methodURL, requestBody, nil, nil)
 	if err != nil {
 		return false, err
 	}
@@ -241,18 +491,23 @@ func (g *Group) JoinRequestAccept(userID string) (bool, error) {
 // Returns an error if the user does not exist, the HTTP request fails,
 // or the response cannot be decoded.
 func (g *Group) JoinRequestDecline(userID string) (bool, error) {
+	return g.JoinRequestDeclineContext(context.Background(), userID)
+}
+
+// JoinRequestDeclineContext is like JoinRequestDecline but uses the provided context for both requests.
+func (g *Group) JoinRequestDeclineContext(ctx context.Context, userID string) (bool, error) {
 	if userID == "" {
 		return false, ErrNoUserID
 	}
 
-	_, err := g.Client.GetUserByID(userID)
+	_, err := g.Client.GetUserByIDContext(ctx, userID)
 	if err != nil {
 		return false, err
 	}
 
-	methodURL := EndpointCloudGroups + g.ID.String() + "/join-requests/" + userID + ":decline"
+	methodURL := g.Client.cloudEndpoint("groups/" + g.ID.String() + "/join-requests/" + userID + ":decline")
 	requestBody := map[string]interface{}{}
-	resp, err := g.Client.post(methodURL, requestBody, nil, nil)
+	resp, err := g.Client.post(ctx, methodURL, requestBody, nil, nil)
 	if err != nil {
 		return false, err
 	}
@@ -263,127 +518,197 @@ func (g *Group) JoinRequestDecline(userID string) (bool, error) {
 
 // GetMembers retrieves all users in the group using the Open Cloud v2 API.
 //
-// Due to current limitations of both the legacy and Open Cloud APIs, there is no
-// direct way to fetch only the user IDs of group members. This method works around that
-// by paginating over the full member list (100 users per request) and polling the
-// endpoint every 200 milliseconds to respect Roblox’s rate limit of 300 requests/minute.
+// It iterates over the full member list (100 users per request) with IterMembers,
+// resolving usernames in batches and roles from a role table fetched once.
+// Every request waits on the client's rate limiter to respect Roblox’s rate limit of 300 requests/minute.
 //
-// For large groups, this process can be slow. It is recommended to cache member data
-// locally and update it periodically instead of calling this method frequently.
+// For large groups, this process can be slow. It is recommended to use IterMembers to
+// stream the members instead, or to cache member data locally and update it periodically.
 //
 // Returns a slice of GroupMember structs. An error is returned if any request fails
-// or a response cannot be decoded. Individual user lookups that fail are skipped.
-//
-// TODO: Consider caching state and repolling periodically in a background session.
+// or a response cannot be decoded. Members whose username cannot be resolved are skipped.
 func (g *Group) GetMembers() (members []GroupMember, err error) {
-	methodURL := EndpointCloudGroups + g.ID.String() + "/memberships"
-	var pageToken string
-
-	rateLimit := time.NewTicker(200 * time.Millisecond)
-	defer rateLimit.Stop()
-	for {
-		<-rateLimit.C
+	return g.GetMembersContext(context.Background())
+}
 
-		query := []queryParam{{Key: "maxPageSize", Value: "100"}}
-		if pageToken != "" {
-			query = append(query, queryParam{Key: "pageToken", Value: pageToken})
+// GetMembersContext is like GetMembers but uses the provided context for every request.
+//
+// Cancelling the context also interrupts any rate limit wait, so a long crawl
+// can be stopped or given a deadline.
+func (g *Group) GetMembersContext(ctx context.Context) (members []GroupMember, err error) {
+	pager := g.IterMembers(MembershipOptions{
+		PageOptions:     PageOptions{PageSize: 100},
+		ExpandUsernames: true,
+		ExpandRoles:     true,
+	})
+
+	err = pager.Each(ctx, func(membership GroupMembership) error {
+		if membership.Username == "" || membership.Role == nil {
+			return nil
 		}
+		members = append(members, GroupMember{
+			ID:        membership.UserID,
+			Username:  membership.Username,
+			GroupRole: *membership.Role,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
-		resp, err := g.Client.get(methodURL, nil, query)
-		if err != nil {
-			return nil, err
-		}
-		defer resp.Body.Close()
+	return members, nil
+}
 
-		var membershipResponse struct {
-			NextPage        string `json:"nextPageToken"`
-			GroupMembership []struct {
-				User string `json:"user"`
-			} `json:"groupMemberships"`
-		}
+// GetMembership retrieves the membership of a specific user within the group, using
+// the Open Cloud memberships filter so that only a single request is made.
+//
+// Returns ErrUserHasNoRole if the user is not a member of the group.
+// Returns an error if the user ID is empty, the HTTP request fails, or the response cannot be decoded.
+func (g *Group) GetMembership(userID string) (*GroupMembership, error) {
+	return g.GetMembershipContext(context.Background(), userID)
+}
 
-		err = json.NewDecoder(resp.Body).Decode(&membershipResponse)
-		if err != nil {
-			return nil, err
-		}
+// GetMembershipContext is like GetMembership but uses the provided context for the request.
+//
+// Memberships are served from the client's cache when possible. Pass a context
+// created with WithoutCache to always fetch the membership from the API.
+func (g *Group) GetMembershipContext(ctx context.Context, userID string) (*GroupMembership, error) {
+	if userID == "" {
+		return nil, ErrNoUserID
+	}
 
-		for _, member := range membershipResponse.GroupMembership {
-			userID := strings.TrimPrefix(member.User, "users/")
+	key := cacheKey(CacheMemberships, g.ID.String(), userID)
+	if cached, ok := g.Client.cacheGet(ctx, CacheMemberships, key); ok {
+		membership := cached.(GroupMembership)
+		return &membership, nil
+	}
 
-			user, err := g.Client.GetUserByID(userID)
-			if err != nil {
-				continue
-			}
+	pager := g.IterMembers(MembershipOptions{
+		PageOptions: PageOptions{PageSize: 1},
+		Filter:      "user == 'users/" + userID + "'",
+	})
+	membership, err := pager.Next(ctx)
+	if err == ErrNoMorePages {
+		return nil, ErrUserHasNoRole
+	}
+	if err != nil {
+		return nil, err
+	}
+	g.Client.cacheSet(CacheMemberships, key, membership)
 
-			role, _ := g.GetUserRole(userID)
+	return &membership, nil
+}
 
-			members = append(members, GroupMember{
-				ID:        userID,
-				Username:  user.Username,
-				GroupRole: *role,
-			})
-		}
+// GetMembersByRole retrieves the memberships of every user holding the specified role,
+// using the Open Cloud memberships filter to page over only those users.
+//
+// Returns an error if the role ID is empty, any request fails, or a response cannot be decoded.
+// Use IterMembers with a role filter to stream the memberships instead.
+func (g *Group) GetMembersByRole(roleID string) ([]GroupMembership, error) {
+	return g.GetMembersByRoleContext(context.Background(), roleID)
+}
 
-		if membershipResponse.NextPage == "" {
-			break
-		}
-		pageToken = membershipResponse.NextPage
+// GetMembersByRoleContext is like GetMembersByRole but uses the provided context for every request.
+func (g *Group) GetMembersByRoleContext(ctx context.Context, roleID string) ([]GroupMembership, error) {
+	if roleID == "" {
+		return nil, ErrNoRoleID
 	}
 
-	return members, nil
+	pager := g.IterMembers(MembershipOptions{
+		PageOptions: PageOptions{PageSize: 100},
+		Filter:      "role == 'groups/" + g.ID.String() + "/roles/" + roleID + "'",
+	})
+
+	return pager.All(ctx)
 }
 
-// GetRoles returns all roles defined within the group.
+// IterMembers returns a Pager over the raw memberships of the group, straight from the
+// Open Cloud memberships endpoint. By default each page costs a single request.
 //
-// Each role is retrieved and resolved into a complete GroupRole object.
-// If a role lookup fails, it is skipped.
-// Returns an error if the HTTP request fails or the response cannot be decoded.
-func (g *Group) GetRoles() (roles []GroupRole, err error) {
-	methodURL := EndpointCloudGroups + g.ID.String() + "/roles"
-	var pageToken string
-
-	rateLimit := time.NewTicker(200 * time.Millisecond)
-	defer rateLimit.Stop()
-	for {
-		<-rateLimit.C
+// Usernames and roles are only resolved when requested through MembershipOptions.
+// Usernames are looked up in batches of up to 100 per page, and roles are resolved
+// from a role table fetched once, the first time it is needed.
+func (g *Group) IterMembers(opts MembershipOptions) *Pager[GroupMembership] {
+	methodURL := g.Client.cloudEndpoint("groups/" + g.ID.String() + "/memberships")
+	var query []queryParam
+	if opts.Filter != "" {
+		query = append(query, queryParam{Key: "filter", Value: opts.Filter})
+	}
+	pager := newPager[GroupMembership](g.Client, methodURL, "groupMemberships", query, opts.PageOptions)
+	if !opts.ExpandUsernames && !opts.ExpandRoles {
+		return pager
+	}
 
-		query := []queryParam{{Key: "maxPageSize", Value: "20"}}
-		if pageToken != "" {
-			query = append(query, queryParam{Key: "pageToken", Value: pageToken})
+	var roleTable map[string]GroupRole
+	pager.prepare = func(ctx context.Context, memberships []GroupMembership) ([]GroupMembership, error) {
+		if opts.ExpandRoles && roleTable == nil {
+			roles, err := g.ListRoles(PageOptions{PageSize: 20}).All(ctx)
+			if err != nil {
+				return nil, err
+			}
+			roleTable = make(map[string]GroupRole, len(roles))
+			for _, role := range roles {
+				roleTable[role.ID.String()] = role
+			}
 		}
 
-		resp, err := g.Client.get(methodURL, nil, query)
-		if err != nil {
-			return nil, err
+		var users map[string]*User
+		if opts.ExpandUsernames {
+			userIDs := make([]string, 0, len(memberships))
+			for _, membership := range memberships {
+				userIDs = append(userIDs, membership.UserID)
+			}
+			var err error
+			users, _, err = g.Client.GetUsersByIDsContext(ctx, userIDs)
+			if err != nil {
+				return nil, err
+			}
 		}
-		defer resp.Body.Close()
 
-		var rolesResponse struct {
-			NextPage   string      `json:"nextPageToken"`
-			GroupRoles []GroupRole `json:"groupRoles"`
+		for i := range memberships {
+			if user, ok := users[memberships[i].UserID]; ok {
+				memberships[i].Username = user.Username
+			}
+			if role, ok := roleTable[memberships[i].RoleID]; ok {
+				memberships[i].Role = &role
+			}
 		}
 
-		err = json.NewDecoder(resp.Body).Decode(&rolesResponse)
-		if err != nil {
-			return nil, err
-		}
+		return memberships, nil
+	}
 
-		for _, role := range rolesResponse.GroupRoles {
-			groupRole, err := g.GetRole(role.ID.String())
-			if err != nil {
-				continue
-			}
+	return pager
+}
 
-			roles = append(roles, *groupRole)
-		}
+// GetRoles returns all roles defined within the group.
+//
+// The roles are read straight from the Open Cloud list endpoint, which returns the full role payload.
+// Returns an error if any HTTP request fails or a response cannot be decoded.
+func (g *Group) GetRoles() (roles []GroupRole, err error) {
+	return g.GetRolesContext(context.Background())
+}
 
-		if rolesResponse.NextPage == "" {
-			break
+// GetRolesContext is like GetRoles but uses the provided context for every request.
+func (g *Group) GetRolesContext(ctx context.Context) (roles []GroupRole, err error) {
+	return g.ListRoles(PageOptions{PageSize: 20}).All(ctx)
+}
+
+// ListRoles returns a Pager over the roles defined within the group, as returned by the
+// Open Cloud list endpoint. Pages are only fetched as the caller iterates.
+//
+// Every role returned is also stored in the client's cache for GetRole.
+func (g *Group) ListRoles(opts PageOptions) *Pager[GroupRole] {
+	methodURL := g.Client.cloudEndpoint("groups/" + g.ID.String() + "/roles")
+	pager := newPager[GroupRole](g.Client, methodURL, "groupRoles", nil, opts)
+	pager.prepare = func(ctx context.Context, roles []GroupRole) ([]GroupRole, error) {
+		for _, role := range roles {
+			g.Client.cacheSet(CacheRoles, cacheKey(CacheRoles, g.ID.String(), role.ID.String()), role)
 		}
-		pageToken = rolesResponse.NextPage
+		return roles, nil
 	}
 
-	return roles, nil
+	return pager
 }
 
 // GetRole retrieves a specific group role by its role ID.
@@ -392,12 +717,26 @@ func (g *Group) GetRoles() (roles []GroupRole, err error) {
 // Returns an error if the role ID is empty, the HTTP request fails,
 // or the response body cannot be decoded.
 func (g *Group) GetRole(roleID string) (role *GroupRole, err error) {
+	return g.GetRoleContext(context.Background(), roleID)
+}
+
+// GetRoleContext is like GetRole but uses the provided context for the request.
+//
+// Roles are served from the client's cache when possible. Pass a context
+// created with WithoutCache to always fetch the role from the API.
+func (g *Group) GetRoleContext(ctx context.Context, roleID string) (role *GroupRole, err error) {
 	if roleID == "" {
 		return nil, ErrNoRoleID
 	}
 
-	methodURL := EndpointCloudGroups + g.ID.String() + "/roles/" + roleID
-	resp, err := g.Client.get(methodURL, nil, nil)
+	key := cacheKey(CacheRoles, g.ID.String(), roleID)
+	if cached, ok := g.Client.cacheGet(ctx, CacheRoles, key); ok {
+		role := cached.(GroupRole)
+		return &role, nil
+	}
+
+	methodURL := g.Client.cloudEndpoint("groups/" + g.ID.String() + "/roles/" + roleID)
+	resp, err := g.Client.get(ctx, methodURL, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -407,58 +746,181 @@ func (g *Group) GetRole(roleID string) (role *GroupRole, err error) {
 	if err != nil {
 		return nil, err
 	}
+	g.Client.cacheSet(CacheRoles, key, *role)
 
 	return role, nil
 }
 
-// GetUserRole retrieves the role of a specific user within the group.
+// CreateRole creates a new role in the group using the legacy Roblox API.
 //
-// This method uses both the legacy Roblox API and the Open Cloud API to determine
-// the user's role. It returns a GroupRole pointer if the user has a role in the group.
-// Returns an error if the user does not exist, if the user has no role in the group,
-// if the HTTP request fails, or if the response body cannot be decoded.
-func (g *Group) GetUserRole(userID string) (*GroupRole, error) {
-	if userID == "" {
-		return nil, ErrNoUserID
+// Creating a role costs Robux, which is charged to the account that owns the API key unless
+// opts.UsingGroupFunds is set.
+//
+// Returns the created GroupRole, without its permissions; use UpdateRolePermissions to set them.
+// Returns ErrInvalidRoleRank if the rank is not between 1 and 254.
+// Returns an error if the HTTP request fails or the response cannot be decoded.
+//
+// Note: This method uses the legacy endpoint at
+// https://groups.roblox.com/v1/groups/{groupID}/rolesets/create, which may be deprecated in the future.
+func (g *Group) CreateRole(opts RoleOptions) (*GroupRole, error) {
+	return g.CreateRoleContext(context.Background(), opts)
+}
+
+// CreateRoleContext is like CreateRole but uses the provided context for the request.
+func (g *Group) CreateRoleContext(ctx context.Context, opts RoleOptions) (*GroupRole, error) {
+	if !assignableRank(opts.Rank) {
+		return nil, ErrInvalidRoleRank
 	}
 
-	user, err := g.Client.GetUserByID(userID)
+	requestBody := map[string]interface{}{
+		"name":            opts.Name,
+		"description":     opts.Description,
+		"rank":            opts.Rank,
+		"usingGroupFunds": opts.UsingGroupFunds,
+	}
+	resp, err := g.Client.post(ctx, g.Client.endpoint(FamilyGroups, "/v1/groups/"+g.ID.String()+"/rolesets/create"), requestBody, nil, nil)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
+
+	return decodeLegacyRole(resp.Body)
+}
 
-	methodURL := EndpointLegacyGroups + "/v2/users/" + user.ID.String() + "/groups/roles"
-	resp, err := g.Client.get(methodURL, nil, nil)
+// UpdateRole changes the name, description and rank of a role in the group using the legacy Roblox API.
+// Only the fields set in opts are sent, so the others keep their current values.
+//
+// Returns the updated GroupRole, without its permissions.
+// Returns ErrInvalidRoleRank if a rank is set that is not between 1 and 254.
+// Returns an error if the role ID is empty, the HTTP request fails, or the response cannot be decoded.
+//
+// Note: This method uses the legacy endpoint at
+// https://groups.roblox.com/v1/groups/{groupID}/rolesets/{roleID}, which may be deprecated in the future.
+func (g *Group) UpdateRole(roleID string, opts RoleOptions) (*GroupRole, error) {
+	return g.UpdateRoleContext(context.Background(), roleID, opts)
+}
+
+// UpdateRoleContext is like UpdateRole but uses the provided context for the request.
+func (g *Group) UpdateRoleContext(ctx context.Context, roleID string, opts RoleOptions) (*GroupRole, error) {
+	if roleID == "" {
+		return nil, ErrNoRoleID
+	}
+	if opts.Rank != 0 && !assignableRank(opts.Rank) {
+		return nil, ErrInvalidRoleRank
+	}
+
+	requestBody := make(map[string]interface{})
+	if opts.Name != "" {
+		requestBody["name"] = opts.Name
+	}
+	if opts.Description != "" {
+		requestBody["description"] = opts.Description
+	}
+	if opts.Rank != 0 {
+		requestBody["rank"] = opts.Rank
+	}
+	resp, err := g.Client.patch(ctx, g.Client.endpoint(FamilyGroups, "/v1/groups/"+g.ID.String()+"/rolesets/"+roleID), requestBody, nil, nil)
+	g.Client.cacheDelete(cacheKey(CacheRoles, g.ID.String(), roleID))
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
-	var groupData struct {
-		Data []struct {
-			Group struct {
-				ID json.Number `json:"id"`
-			} `json:"group"`
-			Role GroupRole `json:"role"`
-		} `json:"data"`
+	return decodeLegacyRole(resp.Body)
+}
+
+// UpdateRolePermissions replaces the permissions of a role in the group using the legacy Roblox API.
+//
+// Returns an error if the role ID is empty or the HTTP request fails.
+//
+// Note: This method uses the legacy endpoint at
+// https://groups.roblox.com/v1/groups/{groupID}/roles/{roleID}/permissions, which may be deprecated in the future.
+func (g *Group) UpdateRolePermissions(roleID string, permissions GroupRolePermissions) error {
+	return g.UpdateRolePermissionsContext(context.Background(), roleID, permissions)
+}
+
+// UpdateRolePermissionsContext is like UpdateRolePermissions but uses the provided context for the request.
+func (g *Group) UpdateRolePermissionsContext(ctx context.Context, roleID string, permissions GroupRolePermissions) error {
+	if roleID == "" {
+		return ErrNoRoleID
 	}
-	err = json.NewDecoder(resp.Body).Decode(&groupData)
+
+	requestBody := map[string]interface{}{"permissions": permissions.legacy()}
+	resp, err := g.Client.patch(ctx, g.Client.endpoint(FamilyGroups, "/v1/groups/"+g.ID.String()+"/roles/"+roleID+"/permissions"), requestBody, nil, nil)
+	g.Client.cacheDelete(cacheKey(CacheRoles, g.ID.String(), roleID))
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// DeleteRole deletes a role from the group using the legacy Roblox API.
+// The role must have no members.
+//
+// Returns true if the role was successfully deleted.
+// Returns an error if the role ID is empty or the HTTP request fails.
+//
+// Note: This method uses the legacy endpoint at
+// https://groups.roblox.com/v1/groups/{groupID}/rolesets/{roleID}, which may be deprecated in the future.
+func (g *Group) DeleteRole(roleID string) (bool, error) {
+	return g.DeleteRoleContext(context.Background(), roleID)
+}
+
+// DeleteRoleContext is like DeleteRole but uses the provided context for the request.
+func (g *Group) DeleteRoleContext(ctx context.Context, roleID string) (bool, error) {
+	if roleID == "" {
+		return false, ErrNoRoleID
+	}
+
+	ok, err := g.Client.delete(ctx, g.Client.endpoint(FamilyGroups, "/v1/groups/"+g.ID.String()+"/rolesets/"+roleID), nil)
+	g.Client.cacheDelete(cacheKey(CacheRoles, g.ID.String(), roleID))
+
+	return ok, err
+}
+
+// decodeLegacyRole decodes a role returned by the legacy rolesets endpoints.
+func decodeLegacyRole(r io.Reader) (*GroupRole, error) {
+	var legacyRole struct {
+		ID          json.Number `json:"id"`
+		Name        string      `json:"name"`
+		Description string      `json:"description"`
+		Rank        json.Number `json:"rank"`
+		MemberCount json.Number `json:"memberCount"`
+	}
+	err := json.NewDecoder(r).Decode(&legacyRole)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, groupData := range groupData.Data {
-		if groupData.Group.ID.String() != g.ID.String() {
-			continue
-		}
-		groupRole, err := g.GetRole(groupData.Role.ID.String())
-		if err != nil {
-			return nil, err
-		}
-		return groupRole, nil
+	return &GroupRole{
+		ID:          legacyRole.ID,
+		Name:        legacyRole.Name,
+		Description: legacyRole.Description,
+		Rank:        legacyRole.Rank,
+		MemberCount: legacyRole.MemberCount,
+	}, nil
+}
+
+// GetUserRole retrieves the role of a specific user within the group.
+//
+// This method looks up the user's membership using the Open Cloud memberships filter,
+// then resolves its role. It returns a GroupRole pointer if the user has a role in the group.
+// Returns an error if the user has no role in the group,
+// if the HTTP request fails, or if the response body cannot be decoded.
+func (g *Group) GetUserRole(userID string) (*GroupRole, error) {
+	return g.GetUserRoleContext(context.Background(), userID)
+}
+
+// GetUserRoleContext is like GetUserRole but uses the provided context for every request.
+func (g *Group) GetUserRoleContext(ctx context.Context, userID string) (*GroupRole, error) {
+	membership, err := g.GetMembershipContext(ctx, userID)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil, ErrUserHasNoRole
+	return g.GetRoleContext(ctx, membership.RoleID)
 }
 
 // UpdateUserRole sets a user's role in the group using the Open Cloud API.
@@ -467,6 +929,11 @@ func (g *Group) GetUserRole(userID string) (*GroupRole, error) {
 // Returns an error if the user ID or role ID is empty, the user or role cannot be found,
 // the HTTP request fails, or the response cannot be decoded.
 func (g *Group) UpdateUserRole(userID string, roleID string) (*GroupRole, error) {
+	return g.UpdateUserRoleContext(context.Background(), userID, roleID)
+}
+
+// UpdateUserRoleContext is like UpdateUserRole but uses the provided context for every request.
+func (g *Group) UpdateUserRoleContext(ctx context.Context, userID string, roleID string) (*GroupRole, error) {
 	if userID == "" {
 		return nil, ErrNoUserID
 	}
@@ -474,28 +941,40 @@ func (g *Group) UpdateUserRole(userID string, roleID string) (*GroupRole, error)
 		return nil, ErrNoRoleID
 	}
 
-	user, err := g.Client.GetUserByID(userID)
+	user, err := g.Client.GetUserByIDContext(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := g.GetRoleContext(ctx, roleID)
 	if err != nil {
 		return nil, err
 	}
 
-	role, err := g.GetRole(roleID)
+	err = g.setMembershipRole(ctx, user.ID.String(), role.ID.String())
 	if err != nil {
 		return nil, err
 	}
 
-	path := fmt.Sprintf("%s/memberships/%s", g.ID.String(), user.ID.String())
+	return role, nil
+}
+
+// setMembershipRole sends the PATCH that moves a member to a role, invalidating their cached membership.
+func (g *Group) setMembershipRole(ctx context.Context, userID string, roleID string) error {
+	path := fmt.Sprintf("%s/memberships/%s", g.ID.String(), userID)
 	requestBody := map[string]string{
 		"path": "groups/" + path,
-		"user": "users/" + user.ID.String(),
-		"role": "groups/" + g.ID.String() + "/roles/" + role.ID.String(),
+		"user": "users/" + userID,
+		"role": "groups/" + g.ID.String() + "/roles/" + roleID,
 	}
-	_, err = g.Client.patch(EndpointCloudGroups+path, nil, requestBody)
+	resp, err := g.Client.patch(ctx, g.Client.cloudEndpoint("groups/"+path), requestBody, nil, nil)
+	g.Client.cacheDelete(cacheKey(CacheMemberships, g.ID.String(), userID))
 	if err != nil {
-		return nil, err
+		return err
 	}
+	resp.Body.Close()
 
-	return role, nil
+	return nil
 }
 
 // RemoveUser removes a user from the group using the legacy Roblox API.
@@ -506,11 +985,20 @@ func (g *Group) UpdateUserRole(userID string, roleID string) (*GroupRole, error)
 // Note: This method uses the legacy endpoint at
 // https://groups.roblox.com/v1/groups/{groupID}/users/{memberID}, which may be deprecated in the future.
 func (g *Group) RemoveUser(userID string) (bool, error) {
+	return g.RemoveUserContext(context.Background(), userID)
+}
+
+// RemoveUserContext is like RemoveUser but uses the provided context for the request.
+func (g *Group) RemoveUserContext(ctx context.Context, userID string) (bool, error) {
 	if userID == "" {
 		return false, ErrNoUserID
 	}
 
-	ok, err := g.Client.delete(EndpointLegacyGroups+g.ID.String()+"/users/"+userID, nil)
+	ok, err := g.Client.delete(ctx, g.Client.endpoint(FamilyGroups, "/v1/groups/"+g.ID.String()+"/users/"+userID), nil)
+	g.Client.cacheDelete(
+		cacheKey(CacheMemberships, g.ID.String(), userID),
+		cacheKey(CacheGroups, g.ID.String()),
+	)
 
 	return ok, err
 }
@@ -526,6 +1014,11 @@ func (g *Group) RemoveUser(userID string) (bool, error) {
 // Note: This method uses the legacy endpoint at
 // https://thumbnails.roblox.com/v1/groups/icons, which may be deprecated in the future.
 func (g *Group) GetGroupIcon(large bool, isCircular bool) (string, error) {
+	return g.GetGroupIconContext(context.Background(), large, isCircular)
+}
+
+// GetGroupIconContext is like GetGroupIcon but uses the provided context for the request.
+func (g *Group) GetGroupIconContext(ctx context.Context, large bool, isCircular bool) (string, error) {
 	size := "150x150"
 	if large {
 		size = "420x420"
@@ -548,7 +1041,7 @@ func (g *Group) GetGroupIcon(large bool, isCircular bool) (string, error) {
 			Value: strconv.FormatBool(isCircular),
 		},
 	}
-	resp, err := g.Client.get(EndpointLegacyGetGroupIcon, nil, querySet)
+	resp, err := g.Client.get(ctx, g.Client.endpoint(FamilyThumbnails, pathLegacyGetGroupIcon), nil, querySet)
 	if err != nil {
 		return "", err
 	}
@@ -566,3 +1059,57 @@ func (g *Group) GetGroupIcon(large bool, isCircular bool) (string, error) {
 
 	return thumbnailResponse.Data[0].ImageURL, nil
 }
+
+// GetShout retrieves the shout currently posted on the group using the Open Cloud API.
+//
+// An error is returned if the HTTP request fails or if the response cannot be decoded.
+func (g *Group) GetShout() (*GroupShout, error) {
+	return g.GetShoutContext(context.Background())
+}
+
+// GetShoutContext is like GetShout but uses the provided context for the request.
+func (g *Group) GetShoutContext(ctx context.Context) (*GroupShout, error) {
+	resp, err := g.Client.get(ctx, g.Client.cloudEndpoint("groups/"+g.ID.String()+"/shout"), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	shout := &GroupShout{}
+	err = json.NewDecoder(resp.Body).Decode(shout)
+	if err != nil {
+		return nil, err
+	}
+
+	return shout, nil
+}
+
+// SetShout posts a new shout on the group using the legacy Roblox API.
+// An empty message clears the shout.
+//
+// Returns the updated GroupShout. An error is returned if the HTTP request fails
+// or if the response cannot be decoded.
+//
+// Note: This method uses the legacy endpoint at
+// https://groups.roblox.com/v1/groups/{groupID}/status, which may be deprecated in the future.
+func (g *Group) SetShout(message string) (*GroupShout, error) {
+	return g.SetShoutContext(context.Background(), message)
+}
+
+// SetShoutContext is like SetShout but uses the provided context for the request.
+func (g *Group) SetShoutContext(ctx context.Context, message string) (*GroupShout, error) {
+	requestBody := map[string]string{"message": message}
+	resp, err := g.Client.patch(ctx, g.Client.endpoint(FamilyGroups, "/v1/groups/"+g.ID.String()+"/status"), requestBody, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	shout := &GroupShout{}
+	err = json.NewDecoder(resp.Body).Decode(shout)
+	if err != nil {
+		return nil, err
+	}
+
+	return shout, nil
+}
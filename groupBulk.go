@@ -0,0 +1,212 @@
+package robloxgo
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+)
+
+// defaultBulkRoleConcurrency is the number of role updates BulkUpdateRoles runs at once,
+// unless set in BulkRoleOptions.
+const defaultBulkRoleConcurrency = 4
+
+// BulkRoleOptions controls how BulkUpdateRoles carries out its updates.
+type BulkRoleOptions struct {
+	// Concurrency is the number of updates sent at once. Defaults to 4.
+	// Every update still waits on the client's rate limiter.
+	Concurrency int
+
+	// DryRun validates the roles and checks that every user exists, with batched user lookups,
+	// without changing anyone's role. Group membership is not checked.
+	DryRun bool
+
+	// StopOnError stops sending new updates after the first failure.
+	// Updates already in flight are left to finish, so their results stay accurate.
+	StopOnError bool
+}
+
+// RoleUpdateResult is the outcome of the role update of a single user in BulkUpdateRoles.
+type RoleUpdateResult struct {
+	// UserID is the unique identifier of the user.
+	UserID string
+
+	// RoleID is the unique identifier of the role the user was moved to.
+	RoleID string
+
+	// Role is the role the user was moved to, if it exists.
+	Role *GroupRole
+
+	// Applied reports whether the user's role was changed. It is always false for a dry run.
+	Applied bool
+
+	// Err is the reason the update failed, or nil if it succeeded.
+	// It is ErrNotAttempted for users skipped after an earlier failure with StopOnError,
+	// or once the context ended.
+	Err error
+}
+
+// BulkUpdateRoles moves many members of the group to new roles, given as a map from user ID to role ID.
+//
+// Every distinct role is looked up once before any update is sent; users moved to a role that
+// Roblox rejects the lookup of, such as one that does not exist, or to the guest or owner role,
+// fail without a request. The updates are then sent concurrently, each as a single membership
+// PATCH with no further lookups.
+//
+// It returns the result of every user, ordered by user ID. The error is only set when a role
+// lookup fails without a response from Roblox, the context ends, or StopOnError is set and an update fails.
+func (g *Group) BulkUpdateRoles(roles map[string]string, opts BulkRoleOptions) ([]RoleUpdateResult, error) {
+	return g.BulkUpdateRolesContext(context.Background(), roles, opts)
+}
+
+// BulkUpdateRolesContext is like BulkUpdateRoles but uses the provided context for every request.
+func (g *Group) BulkUpdateRolesContext(ctx context.Context, roles map[string]string, opts BulkRoleOptions) ([]RoleUpdateResult, error) {
+	results := make([]RoleUpdateResult, 0, len(roles))
+	for userID, roleID := range roles {
+		results = append(results, RoleUpdateResult{UserID: userID, RoleID: roleID})
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if len(results[i].UserID) != len(results[j].UserID) {
+			return len(results[i].UserID) < len(results[j].UserID)
+		}
+		return results[i].UserID < results[j].UserID
+	})
+
+	validated := make(map[string]*GroupRole)
+	invalid := make(map[string]error)
+	for i := range results {
+		result := &results[i]
+		if result.UserID == "" || result.RoleID == "" {
+			continue
+		}
+		if _, ok := validated[result.RoleID]; ok {
+			continue
+		}
+		if _, ok := invalid[result.RoleID]; ok {
+			continue
+		}
+
+		role, err := g.GetRoleContext(ctx, result.RoleID)
+		var apiErr *APIError
+		switch {
+		case err != nil && (ctx.Err() != nil || !errors.As(err, &apiErr)):
+			return nil, err
+		case err != nil:
+			// Roblox rejected the lookup of this role only, so just its users fail.
+			invalid[result.RoleID] = err
+		case role.IsGuest() || role.Rank.String() == "255":
+			invalid[result.RoleID] = ErrUnassignableRole
+		default:
+			validated[result.RoleID] = role
+		}
+	}
+
+	var missingUsers map[string]bool
+	if opts.DryRun {
+		userIDs := make([]string, 0, len(results))
+		for _, result := range results {
+			if result.UserID != "" {
+				userIDs = append(userIDs, result.UserID)
+			}
+		}
+		_, notFound, err := g.Client.GetUsersByIDsContext(ctx, userIDs)
+		if err != nil {
+			return nil, err
+		}
+		missingUsers = make(map[string]bool, len(notFound))
+		for _, userID := range notFound {
+			missingUsers[userID] = true
+		}
+	}
+
+	var firstErr error
+	var pending []int
+	for i := range results {
+		result := &results[i]
+		switch {
+		case result.UserID == "":
+			result.Err = ErrNoUserID
+		case result.RoleID == "":
+			result.Err = ErrNoRoleID
+		case invalid[result.RoleID] != nil:
+			result.Err = invalid[result.RoleID]
+		case missingUsers[result.UserID]:
+			result.Err = ErrUserNotFound
+		default:
+			result.Role = validated[result.RoleID]
+			pending = append(pending, i)
+			continue
+		}
+		if firstErr == nil {
+			firstErr = result.Err
+		}
+	}
+
+	if opts.StopOnError && firstErr != nil {
+		for _, i := range pending {
+			results[i].Err = ErrNotAttempted
+		}
+		return results, firstErr
+	}
+	if opts.DryRun {
+		return results, nil
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultBulkRoleConcurrency
+	}
+
+	// Updates are dispatched by hand rather than with forEachChunk, so that stopping on an
+	// error does not cancel the updates already in flight.
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		updateErr error
+	)
+	semaphore := make(chan struct{}, concurrency)
+	for n, i := range pending {
+		stop := false
+		select {
+		case semaphore <- struct{}{}:
+			mu.Lock()
+			stop = updateErr != nil
+			mu.Unlock()
+			if stop {
+				<-semaphore
+			}
+		case <-ctx.Done():
+			stop = true
+		}
+		if stop {
+			for _, skipped := range pending[n:] {
+				results[skipped].Err = ErrNotAttempted
+			}
+			break
+		}
+
+		wg.Add(1)
+		go func(result *RoleUpdateResult) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			err := g.setMembershipRole(ctx, result.UserID, result.RoleID)
+			result.Err = err
+			result.Applied = err == nil
+			if err != nil && opts.StopOnError {
+				mu.Lock()
+				if updateErr == nil {
+					updateErr = err
+				}
+				mu.Unlock()
+			}
+		}(&results[i])
+	}
+	wg.Wait()
+
+	if updateErr != nil {
+		return results, updateErr
+	}
+
+	return results, ctx.Err()
+}
@@ -0,0 +1,146 @@
+package robloxgo
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// bulkRolesHandler serves role 2 and the guest role 0 of group 7, answers 400 for role 8 and 404 for any other role,
+// knows users 11 to 16, fails the membership update of user 13 and delays those of users 14 and 15.
+func bulkRolesHandler(t *testing.T, mu *sync.Mutex, roleLookups *int, patched *[]string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch && (strings.HasSuffix(r.URL.Path, "/14") || strings.HasSuffix(r.URL.Path, "/15")) {
+			time.Sleep(50 * time.Millisecond)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/users":
+			w.Write([]byte(`{"data":[{"id":11},{"id":12},{"id":13},{"id":14},{"id":15},{"id":16}]}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/cloud/v2/groups/7/roles/0":
+			*roleLookups++
+			w.Write([]byte(`{"id":"0","displayName":"Guest","rank":0}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/cloud/v2/groups/7/roles/2":
+			*roleLookups++
+			w.Write([]byte(`{"id":"2","displayName":"Member","rank":1}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/cloud/v2/groups/7/roles/8":
+			*roleLookups++
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"code":"INVALID_ARGUMENT","message":"invalid role"}`))
+		case r.Method == http.MethodGet:
+			*roleLookups++
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code":"NOT_FOUND","message":"role not found"}`))
+		case r.Method == http.MethodPatch:
+			userID := strings.TrimPrefix(r.URL.Path, "/cloud/v2/groups/7/memberships/")
+			*patched = append(*patched, userID)
+			if userID == "13" {
+				w.WriteHeader(http.StatusForbidden)
+				w.Write([]byte(`{"code":"PERMISSION_DENIED","message":"cannot rank"}`))
+				return
+			}
+			w.Write([]byte(`{}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}
+}
+
+func TestGroup_BulkUpdateRoles(t *testing.T) {
+	var mu sync.Mutex
+	var roleLookups int
+	var patched []string
+	client := newTestClient(t, bulkRolesHandler(t, &mu, &roleLookups, &patched))
+	group := &Group{ID: "7", Client: client}
+
+	results, err := group.BulkUpdateRoles(map[string]string{"11": "2", "12": "2", "13": "2", "100": "9"}, BulkRoleOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if roleLookups != 2 || len(patched) != 3 {
+		t.Fatalf("expected 2 role lookups and 3 updates, got %d and %v", roleLookups, patched)
+	}
+
+	var summary []string
+	for _, result := range results {
+		switch {
+		case result.Applied:
+			summary = append(summary, result.UserID+":ok")
+		case errors.Is(result.Err, ErrPermissionDenied):
+			summary = append(summary, result.UserID+":denied")
+		case errors.Is(result.Err, ErrNotFound):
+			summary = append(summary, result.UserID+":no role")
+		default:
+			summary = append(summary, result.UserID+":"+result.Err.Error())
+		}
+	}
+	if strings.Join(summary, " ") != "11:ok 12:ok 13:denied 100:no role" {
+		t.Fatalf("unexpected results %v", summary)
+	}
+}
+
+func TestGroup_BulkUpdateRolesDryRunAndStop(t *testing.T) {
+	var mu sync.Mutex
+	var roleLookups int
+	var patched []string
+	client := newTestClient(t, bulkRolesHandler(t, &mu, &roleLookups, &patched))
+	group := &Group{ID: "7", Client: client}
+
+	results, err := group.BulkUpdateRoles(map[string]string{"11": "2", "12": "0", "99": "2"}, BulkRoleOptions{DryRun: true})
+	if err != nil || len(results) != 3 || results[0].Role == nil || results[0].Err != nil || results[0].Applied || len(patched) != 0 {
+		t.Fatalf("unexpected dry run %+v: %v", results, err)
+	}
+	if results[1].Err != ErrUnassignableRole || results[2].Err != ErrUserNotFound {
+		t.Fatalf("expected the guest role and unknown user to fail, got %+v", results)
+	}
+
+	results, err = group.BulkUpdateRoles(map[string]string{"13": "2", "14": "2", "15": "2"}, BulkRoleOptions{Concurrency: 1, StopOnError: true})
+	if !errors.Is(err, ErrPermissionDenied) {
+		t.Fatalf("expected ErrPermissionDenied, got %v", err)
+	}
+	if len(patched) != 1 || results[1].Err != ErrNotAttempted || results[2].Err != ErrNotAttempted {
+		t.Fatalf("expected updates to stop after the first failure, got %v and %+v", patched, results)
+	}
+}
+
+func TestGroup_BulkUpdateRolesStopLetsInFlightFinish(t *testing.T) {
+	var mu sync.Mutex
+	var roleLookups int
+	var patched []string
+	client := newTestClient(t, bulkRolesHandler(t, &mu, &roleLookups, &patched))
+	group := &Group{ID: "7", Client: client}
+
+	results, err := group.BulkUpdateRoles(map[string]string{"13": "2", "14": "2", "15": "2", "16": "2"}, BulkRoleOptions{Concurrency: 3, StopOnError: true})
+	if !errors.Is(err, ErrPermissionDenied) {
+		t.Fatalf("expected ErrPermissionDenied, got %v", err)
+	}
+	if !results[1].Applied || !results[2].Applied || results[1].Err != nil || results[2].Err != nil {
+		t.Fatalf("expected the in-flight updates to finish, got %+v", results)
+	}
+	if results[3].Err != ErrNotAttempted || len(patched) != 3 {
+		t.Fatalf("expected no update after the failure, got %v and %+v", patched, results[3])
+	}
+}
+
+func TestGroup_BulkUpdateRolesRejectedRoleLookup(t *testing.T) {
+	var mu sync.Mutex
+	var roleLookups int
+	var patched []string
+	client := newTestClient(t, bulkRolesHandler(t, &mu, &roleLookups, &patched))
+	group := &Group{ID: "7", Client: client}
+
+	results, err := group.BulkUpdateRoles(map[string]string{"11": "2", "12": "8", "14": "2"}, BulkRoleOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if results[0].Err != nil || results[2].Err != nil || !errors.Is(results[1].Err, ErrBadRequest) {
+		t.Fatalf("expected only user 12 to fail with ErrBadRequest, got %+v", results)
+	}
+	if len(patched) != 2 {
+		t.Fatalf("expected users 11 and 14 to be updated, got %v", patched)
+	}
+}
@@ -0,0 +1,364 @@
+package robloxgo
+
+import (
+	"context"
+	"fmt"
+)
+
+// GroupSpec describes the desired role layout of a group, for use with Group.Plan.
+// It can be loaded from a JSON or YAML file.
+type GroupSpec struct {
+	// Roles lists the roles the group should have.
+	Roles []RoleSpec `json:"roles" yaml:"roles"`
+}
+
+// RoleSpec describes a role of a GroupSpec.
+type RoleSpec struct {
+	// Name is the display name of the role. Names must be unique within a spec.
+	Name string `json:"name" yaml:"name"`
+
+	// Description is the description of the role.
+	// Roles without a description in the spec keep their current description.
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+
+	// Rank is the hierarchical rank of the role, between 0 and 255.
+	Rank int `json:"rank" yaml:"rank"`
+
+	// Permissions, if set, are the permissions the role should have.
+	// Roles without permissions in the spec keep their current permissions.
+	Permissions *GroupRolePermissions `json:"permissions,omitempty" yaml:"permissions,omitempty"`
+}
+
+// RoleChangeAction is the kind of change a RoleChange makes to a role.
+type RoleChangeAction string
+
+// Actions of the changes in a GroupPlan.
+const (
+	// RoleCreate creates a role that is in the spec but not in the group.
+	RoleCreate RoleChangeAction = "create"
+
+	// RoleRename changes the name of a role.
+	RoleRename RoleChangeAction = "rename"
+
+	// RoleRerank changes the rank of a role.
+	RoleRerank RoleChangeAction = "rerank"
+
+	// RoleDescribe changes the description of a role.
+	RoleDescribe RoleChangeAction = "describe"
+
+	// RolePermissions changes the permissions of a role.
+	RolePermissions RoleChangeAction = "permissions"
+)
+
+// RoleChange is a single change to a group role, planned by Group.Plan.
+//
+// Each change only changes the field named by its Action; Apply takes the role's other
+// fields from its state as left by the previous changes, so a change removed from a plan
+// during review is not made by the changes that follow it.
+type RoleChange struct {
+	// Action is the kind of change.
+	Action RoleChangeAction `json:"action" yaml:"action"`
+
+	// RoleID is the unique identifier of the changed role. It is empty for RoleCreate.
+	RoleID string `json:"roleId,omitempty" yaml:"roleId,omitempty"`
+
+	// From is the state of the role before the change. It is nil for RoleCreate.
+	From *RoleSpec `json:"from,omitempty" yaml:"from,omitempty"`
+
+	// To is the state of the role once the change is applied.
+	To RoleSpec `json:"to" yaml:"to"`
+}
+
+// GroupPlan is the list of role changes that converge a group to a GroupSpec.
+// It can be serialized to JSON or YAML for review before it is passed to Group.Apply.
+type GroupPlan struct {
+	// GroupID is the unique identifier of the group the plan was made for.
+	GroupID string `json:"groupId" yaml:"groupId"`
+
+	// Changes lists the changes to make, in order: re-ranks first, then creates,
+	// then renames, description and permission changes in the order of the spec's roles.
+	Changes []RoleChange `json:"changes" yaml:"changes"`
+}
+
+// Limits of the ranks that roles can be created or re-ranked to. Rank 0 is held by
+// the guest role and rank 255 by the owner role, which cannot be moved.
+const (
+	minAssignableRank = 1
+	maxAssignableRank = 254
+)
+
+// validate checks that every role of the spec has a unique name and a unique rank within range.
+func (s GroupSpec) validate() error {
+	names := make(map[string]bool, len(s.Roles))
+	ranks := make(map[int]string, len(s.Roles))
+	for _, role := range s.Roles {
+		if role.Name == "" {
+			return fmt.Errorf("%w: role with rank %d has no name", ErrInvalidGroupSpec, role.Rank)
+		}
+		if names[role.Name] {
+			return fmt.Errorf("%w: duplicate role %q", ErrInvalidGroupSpec, role.Name)
+		}
+		if role.Rank < 0 || role.Rank > 255 {
+			return fmt.Errorf("%w: role %q has rank %d outside 0-255", ErrInvalidGroupSpec, role.Name, role.Rank)
+		}
+		if other, ok := ranks[role.Rank]; ok {
+			return fmt.Errorf("%w: roles %q and %q share rank %d", ErrInvalidGroupSpec, other, role.Name, role.Rank)
+		}
+		names[role.Name] = true
+		ranks[role.Rank] = role.Name
+	}
+
+	return nil
+}
+
+// assignableRank reports whether roles can be created or re-ranked to the rank.
+func assignableRank(rank int) bool {
+	return rank >= minAssignableRank && rank <= maxAssignableRank
+}
+
+// Plan compares the group's current roles with the spec and returns the changes that
+// would make them match. No changes are made to the group.
+//
+// Spec roles are matched to the group's roles by name, then by rank, so a role whose name
+// changed in the spec is renamed rather than recreated. Roles of the group that are not
+// in the spec are left untouched. Permissions can only be compared if the API key is
+// allowed to read role permissions.
+//
+// The spec is rejected if two roles would share a rank, if a role would be created or
+// re-ranked outside ranks 1-254, or if the guest role would be renamed, so that such
+// specs fail before Apply changes anything. Re-ranks are ordered so that no role is
+// moved to a rank still held by another; roles that swap ranks pass through a free rank.
+//
+// Returns an error wrapping ErrInvalidGroupSpec if the spec is invalid,
+// or an error if the roles cannot be retrieved.
+func (g *Group) Plan(spec GroupSpec) (*GroupPlan, error) {
+	return g.PlanContext(context.Background(), spec)
+}
+
+// PlanContext is like Plan but uses the provided context for every request.
+func (g *Group) PlanContext(ctx context.Context, spec GroupSpec) (*GroupPlan, error) {
+	if err := spec.validate(); err != nil {
+		return nil, err
+	}
+
+	roles, err := g.GetRolesContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	current := make([]*GroupRole, len(spec.Roles))
+	matched := make(map[string]bool, len(roles))
+	for i, want := range spec.Roles {
+		for j := range roles {
+			if !matched[roles[j].ID.String()] && roles[j].Name == want.Name {
+				current[i] = &roles[j]
+				matched[roles[j].ID.String()] = true
+				break
+			}
+		}
+	}
+	for i, want := range spec.Roles {
+		if current[i] != nil {
+			continue
+		}
+		for j := range roles {
+			if !matched[roles[j].ID.String()] && roles[j].Rank.String() == fmt.Sprint(want.Rank) {
+				current[i] = &roles[j]
+				matched[roles[j].ID.String()] = true
+				break
+			}
+		}
+	}
+
+	// occupied maps every rank held in the group to the ID of the role holding it.
+	occupied := make(map[int]string, len(roles))
+	state := make(map[string]RoleSpec, len(roles))
+	for _, role := range roles {
+		rank, _ := role.Rank.Int64()
+		permissions := role.Permissions
+		state[role.ID.String()] = RoleSpec{
+			Name:        role.Name,
+			Description: role.Description,
+			Rank:        int(rank),
+			Permissions: &permissions,
+		}
+		occupied[int(rank)] = role.ID.String()
+	}
+	for _, role := range roles {
+		rank := state[role.ID.String()].Rank
+		if matched[role.ID.String()] {
+			continue
+		}
+		for _, want := range spec.Roles {
+			if want.Rank == rank {
+				return nil, fmt.Errorf("%w: rank %d of role %q is held by role %q, which is not in the spec", ErrInvalidGroupSpec, rank, want.Name, role.Name)
+			}
+		}
+	}
+
+	plan := &GroupPlan{GroupID: g.ID.String()}
+	change := func(action RoleChangeAction, roleID string, apply func(*RoleSpec)) {
+		from := state[roleID]
+		to := from
+		apply(&to)
+		state[roleID] = to
+		plan.Changes = append(plan.Changes, RoleChange{Action: action, RoleID: roleID, From: &from, To: to})
+	}
+
+	type rerank struct {
+		roleID string
+		rank   int
+	}
+	var reranks []rerank
+	for i, want := range spec.Roles {
+		role := current[i]
+		if role == nil {
+			if !assignableRank(want.Rank) {
+				return nil, fmt.Errorf("%w: role %q cannot be created at rank %d", ErrInvalidGroupSpec, want.Name, want.Rank)
+			}
+			continue
+		}
+
+		from := state[role.ID.String()]
+		if from.Rank == 0 && from.Name != want.Name {
+			return nil, fmt.Errorf("%w: the guest role %q cannot be renamed", ErrInvalidGroupSpec, from.Name)
+		}
+		if from.Rank != want.Rank {
+			if !assignableRank(from.Rank) || !assignableRank(want.Rank) {
+				return nil, fmt.Errorf("%w: role %q cannot be re-ranked from %d to %d", ErrInvalidGroupSpec, from.Name, from.Rank, want.Rank)
+			}
+			reranks = append(reranks, rerank{roleID: role.ID.String(), rank: want.Rank})
+		}
+	}
+
+	// Move every role whose target rank is free; when only swaps remain, move one
+	// of them to a free rank first to break the cycle.
+	for len(reranks) > 0 {
+		var blocked []rerank
+		for _, r := range reranks {
+			if _, held := occupied[r.rank]; held {
+				blocked = append(blocked, r)
+				continue
+			}
+			delete(occupied, state[r.roleID].Rank)
+			occupied[r.rank] = r.roleID
+			rank := r.rank
+			change(RoleRerank, r.roleID, func(to *RoleSpec) { to.Rank = rank })
+		}
+		if len(blocked) == len(reranks) {
+			free := 0
+			for rank := minAssignableRank; rank <= maxAssignableRank && free == 0; rank++ {
+				if _, held := occupied[rank]; !held {
+					free = rank
+				}
+			}
+			if free == 0 {
+				return nil, fmt.Errorf("%w: no free rank to reorder roles through", ErrInvalidGroupSpec)
+			}
+			r := blocked[0]
+			delete(occupied, state[r.roleID].Rank)
+			occupied[free] = r.roleID
+			change(RoleRerank, r.roleID, func(to *RoleSpec) { to.Rank = free })
+		}
+		reranks = blocked
+	}
+
+	for i, want := range spec.Roles {
+		if current[i] == nil {
+			plan.Changes = append(plan.Changes, RoleChange{Action: RoleCreate, To: want})
+		}
+	}
+
+	for i, want := range spec.Roles {
+		role := current[i]
+		if role == nil {
+			continue
+		}
+
+		roleID := role.ID.String()
+		if state[roleID].Name != want.Name {
+			change(RoleRename, roleID, func(to *RoleSpec) { to.Name = want.Name })
+		}
+		if want.Description != "" && state[roleID].Description != want.Description {
+			change(RoleDescribe, roleID, func(to *RoleSpec) { to.Description = want.Description })
+		}
+		// Roles keep their current permissions unless the spec sets them.
+		if want.Permissions != nil && *state[roleID].Permissions != *want.Permissions {
+			change(RolePermissions, roleID, func(to *RoleSpec) { to.Permissions = want.Permissions })
+		}
+	}
+
+	return plan, nil
+}
+
+// Apply carries out the changes of a plan made by Plan, in order, stopping at the first failure.
+//
+// Each change only sends the field named by its Action, taking the role's other fields
+// from the From of its first change and the changes applied since, so removing a change
+// from a reviewed plan leaves that field untouched.
+//
+// Returns ErrPlanGroupMismatch if the plan was made for another group, or an error
+// naming the change that failed. Changes made before a failure are not undone.
+func (g *Group) Apply(plan *GroupPlan) error {
+	return g.ApplyContext(context.Background(), plan)
+}
+
+// ApplyContext is like Apply but uses the provided context for every request.
+func (g *Group) ApplyContext(ctx context.Context, plan *GroupPlan) error {
+	if plan.GroupID != g.ID.String() {
+		return ErrPlanGroupMismatch
+	}
+
+	state := make(map[string]RoleSpec)
+	for _, change := range plan.Changes {
+		var err error
+		switch change.Action {
+		case RoleCreate:
+			var role *GroupRole
+			role, err = g.CreateRoleContext(ctx, RoleOptions{
+				Name:        change.To.Name,
+				Description: change.To.Description,
+				Rank:        change.To.Rank,
+			})
+			if err == nil && change.To.Permissions != nil {
+				err = g.UpdateRolePermissionsContext(ctx, role.ID.String(), *change.To.Permissions)
+			}
+		case RoleRename, RoleRerank, RoleDescribe:
+			role, ok := state[change.RoleID]
+			if !ok {
+				if change.From == nil {
+					err = fmt.Errorf("change has no from state")
+					break
+				}
+				role = *change.From
+			}
+			switch change.Action {
+			case RoleRename:
+				role.Name = change.To.Name
+			case RoleRerank:
+				role.Rank = change.To.Rank
+			case RoleDescribe:
+				role.Description = change.To.Description
+			}
+			_, err = g.UpdateRoleContext(ctx, change.RoleID, RoleOptions{
+				Name:        role.Name,
+				Description: role.Description,
+				Rank:        role.Rank,
+			})
+			if err == nil {
+				state[change.RoleID] = role
+			}
+		case RolePermissions:
+			if change.To.Permissions != nil {
+				err = g.UpdateRolePermissionsContext(ctx, change.RoleID, *change.To.Permissions)
+			}
+		default:
+			err = fmt.Errorf("unknown action %q", change.Action)
+		}
+		if err != nil {
+			return fmt.Errorf("%s role %q: %w", change.Action, change.To.Name, err)
+		}
+	}
+
+	return nil
+}
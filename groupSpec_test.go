@@ -0,0 +1,148 @@
+package robloxgo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// rolesHandler serves the roles of group 7 and records every write request made to the legacy API.
+func rolesHandler(t *testing.T, writes *[]string) http.HandlerFunc {
+	roles := map[string]string{
+		"0": `{"id":"0","displayName":"Guest","rank":0,"permissions":{"viewWallPosts":true}}`,
+		"1": `{"id":"1","displayName":"Member","rank":1,"permissions":{"viewWallPosts":true}}`,
+		"2": `{"id":"2","displayName":"Moderator","rank":100,"description":"Mods"}`,
+		"3": `{"id":"3","displayName":"Admin","rank":200}`,
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/cloud/v2/groups/7/roles":
+			w.Write([]byte(`{"groupRoles":[` + roles["0"] + `,` + roles["1"] + `,` + roles["2"] + `,` + roles["3"] + `]}`))
+		case strings.HasPrefix(r.URL.Path, "/cloud/v2/groups/7/roles/"):
+			w.Write([]byte(roles[strings.TrimPrefix(r.URL.Path, "/cloud/v2/groups/7/roles/")]))
+		default:
+			*writes = append(*writes, r.Method+" "+r.URL.Path)
+			w.Write([]byte(`{"id":10,"name":"VIP","rank":50}`))
+		}
+	}
+}
+
+func TestGroup_PlanAndApply(t *testing.T) {
+	var writes []string
+	client := newTestClient(t, rolesHandler(t, &writes))
+	group := &Group{ID: "7", Client: client}
+
+	spec := GroupSpec{Roles: []RoleSpec{
+		{Name: "Guest", Rank: 0},
+		{Name: "Member", Rank: 1, Permissions: &GroupRolePermissions{ViewWallPosts: true, CreateWallPosts: true}},
+		{Name: "VIP", Rank: 50},
+		{Name: "Mod", Rank: 100, Description: "Mods"},
+		{Name: "Admin", Rank: 150},
+	}}
+	plan, err := group.Plan(spec)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var actions []string
+	for _, change := range plan.Changes {
+		actions = append(actions, string(change.Action)+":"+change.RoleID+":"+change.To.Name)
+	}
+	expected := "rerank:3:Admin create::VIP permissions:1:Member rename:2:Mod"
+	if strings.Join(actions, " ") != expected {
+		t.Fatalf("unexpected plan %v, expected %s", actions, expected)
+	}
+
+	encoded, err := json.Marshal(plan)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var decoded GroupPlan
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := group.Apply(&decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected = "PATCH /v1/groups/7/rolesets/3 POST /v1/groups/7/rolesets/create PATCH /v1/groups/7/roles/1/permissions PATCH /v1/groups/7/rolesets/2"
+	if strings.Join(writes, " ") != expected {
+		t.Fatalf("unexpected writes %v", writes)
+	}
+}
+
+func TestGroup_PlanInvalidSpec(t *testing.T) {
+	group := &Group{ID: "7"}
+	_, err := group.Plan(GroupSpec{Roles: []RoleSpec{{Name: "A", Rank: 1}, {Name: "A", Rank: 2}}})
+	if !errors.Is(err, ErrInvalidGroupSpec) {
+		t.Fatalf("expected ErrInvalidGroupSpec, got %v", err)
+	}
+
+	invalid := map[string]GroupSpec{
+		"duplicate rank": {Roles: []RoleSpec{{Name: "A", Rank: 5}, {Name: "B", Rank: 5}}},
+		"create at 255":  {Roles: []RoleSpec{{Name: "Owner", Rank: 255}}},
+		"rerank to 0":    {Roles: []RoleSpec{{Name: "Admin", Rank: 0}}},
+		"rename guest":   {Roles: []RoleSpec{{Name: "Visitor", Rank: 0}}},
+		"rank held":      {Roles: []RoleSpec{{Name: "Admin", Rank: 100}}},
+	}
+	var writes []string
+	group = &Group{ID: "7", Client: newTestClient(t, rolesHandler(t, &writes))}
+	for name, spec := range invalid {
+		if _, err := group.Plan(spec); !errors.Is(err, ErrInvalidGroupSpec) {
+			t.Errorf("%s: expected ErrInvalidGroupSpec, got %v", name, err)
+		}
+	}
+
+	err = group.Apply(&GroupPlan{GroupID: "8"})
+	if err != ErrPlanGroupMismatch {
+		t.Fatalf("expected ErrPlanGroupMismatch, got %v", err)
+	}
+}
+
+func TestGroup_PlanSwapsRanksThroughFreeRank(t *testing.T) {
+	var writes []string
+	client := newTestClient(t, rolesHandler(t, &writes))
+	group := &Group{ID: "7", Client: client}
+
+	plan, err := group.Plan(GroupSpec{Roles: []RoleSpec{
+		{Name: "Moderator", Rank: 200, Description: "Mods"},
+		{Name: "Admin", Rank: 100},
+	}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var ranks []string
+	for _, change := range plan.Changes {
+		ranks = append(ranks, fmt.Sprintf("%s:%d->%d", change.RoleID, change.From.Rank, change.To.Rank))
+	}
+	if strings.Join(ranks, " ") != "2:100->2 3:200->100 2:2->200" {
+		t.Fatalf("unexpected reranks %v", ranks)
+	}
+}
+
+func TestGroup_ApplySkipsRemovedChanges(t *testing.T) {
+	var bodies []map[string]interface{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		bodies = append(bodies, body)
+		w.Write([]byte(`{"id":2,"name":"Moderator","rank":150}`))
+	})
+	group := &Group{ID: "7", Client: client}
+
+	// A reviewer removed the rename of role 2 from the plan, keeping its re-rank.
+	from := &RoleSpec{Name: "Moderator", Description: "Mods", Rank: 100}
+	plan := &GroupPlan{GroupID: "7", Changes: []RoleChange{
+		{Action: RoleRerank, RoleID: "2", From: from, To: RoleSpec{Name: "Mod", Description: "Mods", Rank: 150}},
+	}}
+	if err := group.Apply(plan); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(bodies) != 1 || bodies[0]["name"] != "Moderator" || bodies[0]["rank"].(float64) != 150 {
+		t.Fatalf("expected only the rank to change, got %v", bodies)
+	}
+}
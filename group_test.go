@@ -1,8 +1,13 @@
 package robloxgo
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
 	"os"
 	"testing"
+	"time"
 )
 
 func TestGetGroup_EmptyGroupID(t *testing.T) {
@@ -207,4 +212,284 @@ func TestGroupMemberUpdate(t *testing.T) {
 	if role == nil {
 		t.Fatal("expected role, got nil")
 	}
-}
\ No newline at end of file
+}
+
+// membersHandler serves a fake group 7 with two members and two roles, counting requests per path.
+func membersHandler(t *testing.T, calls map[string]int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		calls[r.URL.Path]++
+		switch r.URL.Path {
+		case "/cloud/v2/groups/7/memberships":
+			w.Write([]byte(`{"groupMemberships":[
+				{"path":"groups/7/memberships/a","user":"users/1","role":"groups/7/roles/10","createTime":"2024-01-01T00:00:00Z"},
+				{"path":"groups/7/memberships/b","user":"users/2","role":"groups/7/roles/20","createTime":"2024-01-02T00:00:00Z"}
+			],"nextPageToken":""}`))
+		case "/cloud/v2/groups/7/roles":
+			w.Write([]byte(`{"groupRoles":[{"id":"10","displayName":"Member","rank":1},{"id":"20","displayName":"Owner","rank":255}]}`))
+		case "/v1/users":
+			w.Write([]byte(`{"data":[{"id":1,"name":"One"},{"id":2,"name":"Two"}]}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+}
+
+func TestIterMembers_Raw(t *testing.T) {
+	calls := map[string]int{}
+	client := newTestClient(t, membersHandler(t, calls))
+	group := &Group{ID: "7", Client: client}
+
+	memberships, err := group.IterMembers(MembershipOptions{}).All(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(memberships) != 2 || memberships[0].UserID != "1" || memberships[0].RoleID != "10" {
+		t.Fatalf("unexpected memberships: %+v", memberships)
+	}
+	if memberships[0].CreatedAt.IsZero() || memberships[0].Role != nil {
+		t.Fatalf("unexpected membership fields: %+v", memberships[0])
+	}
+	if len(calls) != 1 {
+		t.Fatalf("expected only the memberships endpoint to be called, got %v", calls)
+	}
+}
+
+func TestGetMembers_Expanded(t *testing.T) {
+	calls := map[string]int{}
+	client := newTestClient(t, membersHandler(t, calls))
+	group := &Group{ID: "7", Client: client}
+
+	members, err := group.GetMembers()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(members) != 2 || members[1].Username != "Two" || members[1].GroupRole.Name != "Owner" {
+		t.Fatalf("unexpected members: %+v", members)
+	}
+	for path, count := range calls {
+		if count != 1 {
+			t.Fatalf("expected a single request to %s, got %d", path, count)
+		}
+	}
+}
+
+func TestGetUserRole_Filter(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/cloud/v2/groups/7/memberships":
+			if filter := r.URL.Query().Get("filter"); filter != "user == 'users/1'" {
+				t.Errorf("unexpected filter %q", filter)
+			}
+			w.Write([]byte(`{"groupMemberships":[{"user":"users/1","role":"groups/7/roles/10"}]}`))
+		case "/cloud/v2/groups/7/roles/10":
+			w.Write([]byte(`{"id":"10","displayName":"Member","rank":1}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	group := &Group{ID: "7", Client: client}
+
+	role, err := group.GetUserRole("1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if role.Name != "Member" {
+		t.Fatalf("expected role Member, got %q", role.Name)
+	}
+}
+
+func TestGetMembership_NotMember(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"groupMemberships":[]}`))
+	})
+	group := &Group{ID: "7", Client: client}
+
+	_, err := group.GetMembership("1")
+	if err != ErrUserHasNoRole {
+		t.Fatalf("expected ErrUserHasNoRole, got %v", err)
+	}
+}
+
+func TestGroupShout(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/cloud/v2/groups/7/shout":
+			w.Write([]byte(`{"path":"groups/7/shout","content":"Welcome!","poster":"users/42",
+				"createTime":"2025-01-01T00:00:00Z","updateTime":"2025-01-02T00:00:00Z"}`))
+		case r.Method == http.MethodPatch && r.URL.Path == "/v1/groups/7/status":
+			var body map[string]string
+			json.NewDecoder(r.Body).Decode(&body)
+			w.Write([]byte(`{"body":"` + body["message"] + `","poster":{"userId":43,"username":"bot"},
+				"created":"2025-01-01T00:00:00Z","updated":"2025-01-03T00:00:00Z"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	group := &Group{ID: "7", Client: client}
+
+	shout, err := group.GetShout()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if shout.Content != "Welcome!" || shout.PosterID != "42" || shout.UpdatedAt.Day() != 2 {
+		t.Fatalf("unexpected shout: %+v", shout)
+	}
+
+	shout, err = group.SetShout("Event at 5pm")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if shout.Content != "Event at 5pm" || shout.PosterID != "43" || shout.UpdatedAt.Day() != 3 {
+		t.Fatalf("unexpected shout: %+v", shout)
+	}
+}
+
+func TestGetRole_FullPayload(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"2","displayName":"Moderator","description":"Keeps the peace","rank":200,"memberCount":12,
+			"permissions":{"viewWallPosts":true,"deleteWallPosts":true,"exileMembers":true,"viewAuditLog":true}}`))
+	})
+	group := &Group{ID: "7", Client: client}
+
+	role, err := group.GetRole("2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if role.Description != "Keeps the peace" || role.MemberCount.String() != "12" || role.IsGuest() {
+		t.Fatalf("unexpected role: %+v", role)
+	}
+	if !role.Permissions.ExileMembers || !role.Permissions.ViewAuditLog || role.Permissions.SpendGroupFunds {
+		t.Fatalf("unexpected permissions: %+v", role.Permissions)
+	}
+}
+
+func TestGroupRoleCRUD(t *testing.T) {
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		switch r.Method + " " + r.URL.Path {
+		case "POST /v1/groups/7/rolesets/create":
+			if body["name"] != "Helper" || body["rank"].(float64) != 50 || body["usingGroupFunds"] != true {
+				t.Errorf("unexpected body %v", body)
+			}
+			w.Write([]byte(`{"id":9,"name":"Helper","description":"","rank":50,"memberCount":0}`))
+		case "PATCH /v1/groups/7/rolesets/9":
+			if len(body) != 1 || body["rank"].(float64) != 60 {
+				t.Errorf("expected only the rank to be sent, got %v", body)
+			}
+			w.Write([]byte(`{"id":9,"name":"Helpers","description":"Helps","rank":60,"memberCount":0}`))
+		case "PATCH /v1/groups/7/roles/9/permissions":
+			permissions := body["permissions"].(map[string]interface{})
+			if permissions["PostToStatus"] != true || permissions["ViewWall"] != false {
+				t.Errorf("unexpected permissions %v", permissions)
+			}
+			w.Write([]byte(`{}`))
+		case "DELETE /v1/groups/7/rolesets/9":
+			w.Write([]byte(`{}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	group := &Group{ID: "7", Client: client}
+
+	role, err := group.CreateRole(RoleOptions{Name: "Helper", Rank: 50, UsingGroupFunds: true})
+	if err != nil || role.ID.String() != "9" || role.Name != "Helper" {
+		t.Fatalf("unexpected role %+v: %v", role, err)
+	}
+	role, err = group.UpdateRole("9", RoleOptions{Rank: 60})
+	if err != nil || role.Name != "Helpers" || role.Rank.String() != "60" {
+		t.Fatalf("unexpected role %+v: %v", role, err)
+	}
+	if err := group.UpdateRolePermissions("9", GroupRolePermissions{CreateGroupShout: true}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ok, err := group.DeleteRole("9"); !ok || err != nil {
+		t.Fatalf("unexpected delete result %v: %v", ok, err)
+	}
+	if len(requests) != 4 {
+		t.Fatalf("unexpected requests: %v", requests)
+	}
+}
+
+func TestGroupRoleInvalidRank(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+	group := &Group{ID: "7", Client: client}
+
+	for _, rank := range []int{0, 255} {
+		if _, err := group.CreateRole(RoleOptions{Name: "Helper", Rank: rank}); !errors.Is(err, ErrInvalidRoleRank) {
+			t.Fatalf("expected ErrInvalidRoleRank creating rank %d, got %v", rank, err)
+		}
+	}
+	if _, err := group.UpdateRole("9", RoleOptions{Rank: 255}); !errors.Is(err, ErrInvalidRoleRank) {
+		t.Fatalf("expected ErrInvalidRoleRank, got %v", err)
+	}
+}
+
+func TestGetJoinRequestsContext_CancelledMidRequest(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("pageToken") == "" {
+			w.Write([]byte(`{"groupJoinRequests":[],"nextPageToken":"next"}`))
+			return
+		}
+		<-r.Context().Done()
+	})
+	group := &Group{ID: "7", Client: client}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err := group.GetJoinRequestsContext(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("expected the request to stop promptly, took %v", elapsed)
+	}
+}
+
+func TestGetMembersContext_CancelledBetweenPages(t *testing.T) {
+	var pages int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		pages++
+		w.Write([]byte(`{"groupMemberships":[],"nextPageToken":"next"}`))
+	}, WithRateLimits(map[RateLimitBucket]RateLimit{
+		BucketCloudGroups: {Requests: 1, Per: time.Hour, Burst: 1},
+	}))
+	group := &Group{ID: "7", Client: client}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err := group.GetMembersContext(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second || pages != 1 {
+		t.Fatalf("expected the wait for the second page to be cut short, took %v for %d pages", elapsed, pages)
+	}
+}
+
+func TestGetRolesContext_SingleListRequest(t *testing.T) {
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path)
+		w.Write([]byte(`{"groupRoles":[{"id":"1","displayName":"Member","rank":1,"memberCount":5},{"id":"2","displayName":"Admin","rank":200}]}`))
+	}, WithCache(nil))
+	group := &Group{ID: "7", Client: client}
+
+	roles, err := group.GetRolesContext(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(roles) != 2 || roles[0].MemberCount.String() != "5" || len(requests) != 1 {
+		t.Fatalf("expected 2 roles from a single request, got %+v from %v", roles, requests)
+	}
+}
@@ -0,0 +1,197 @@
+package robloxgo
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// LuauTaskState is the state of a Luau execution session task.
+type LuauTaskState string
+
+// States of a Luau execution session task.
+const (
+	LuauTaskQueued     LuauTaskState = "QUEUED"
+	LuauTaskProcessing LuauTaskState = "PROCESSING"
+	LuauTaskComplete   LuauTaskState = "COMPLETE"
+	LuauTaskFailed     LuauTaskState = "FAILED"
+	LuauTaskCancelled  LuauTaskState = "CANCELLED"
+)
+
+// Delays between the polls of a running Luau task made by ExecuteLuau, unless set in LuauOptions.
+const (
+	defaultLuauPollInterval    = time.Second
+	defaultLuauMaxPollInterval = 10 * time.Second
+)
+
+// LuauOptions controls the place version a Luau script runs against and how its task is polled.
+type LuauOptions struct {
+	// VersionNumber is the place version to run the script against. Defaults to the latest version.
+	VersionNumber int64
+
+	// Timeout is how long the script may run before it is stopped. Defaults to the API's limit.
+	Timeout time.Duration
+
+	// PollInterval is the delay before the first poll of the task, doubled after every poll
+	// up to MaxPollInterval. Defaults to 1 second and 10 seconds.
+	PollInterval    time.Duration
+	MaxPollInterval time.Duration
+}
+
+// LuauTask represents a Luau execution session task and, once it is done, its results.
+type LuauTask struct {
+	// Path is the Open Cloud resource path of the task.
+	Path string `json:"path"`
+
+	// State is the current state of the task.
+	State LuauTaskState `json:"state"`
+
+	// Script is the Luau source the task runs.
+	Script string `json:"script"`
+
+	// Output holds the JSON encoded values returned by the script, once the task is complete.
+	Output struct {
+		Results []json.RawMessage `json:"results"`
+	} `json:"output"`
+
+	// Error describes why the script failed, if the task failed.
+	Error *LuauTaskError `json:"error"`
+
+	// CreatedAt is the timestamp of when the task was created.
+	CreatedAt time.Time `json:"createTime"`
+
+	// UpdatedAt is the timestamp of when the task was last updated.
+	UpdatedAt time.Time `json:"updateTime"`
+
+	// Client is the API client used to interact with the task.
+	Client *Client
+}
+
+// LuauTaskError is the error of a failed Luau execution session task.
+// ExecuteLuau returns it as the error when the script fails.
+type LuauTaskError struct {
+	// Code is the kind of failure, such as SCRIPT_ERROR or DEADLINE_EXCEEDED.
+	Code string `json:"code"`
+
+	// Message is the error raised by the script, or a description of the failure.
+	Message string `json:"message"`
+}
+
+// Error implements the error interface.
+func (e *LuauTaskError) Error() string {
+	return "luau task failed: " + e.Code + ": " + e.Message
+}
+
+// LuauTaskLog is a chunk of the messages printed by a Luau execution session task.
+type LuauTaskLog struct {
+	// Path is the Open Cloud resource path of the log chunk.
+	Path string `json:"path"`
+
+	// Messages holds the printed messages, in order.
+	Messages []string `json:"messages"`
+}
+
+// Done reports whether the task has finished running, successfully or not.
+func (t *LuauTask) Done() bool {
+	return t.State == LuauTaskComplete || t.State == LuauTaskFailed || t.State == LuauTaskCancelled
+}
+
+// Logs returns a Pager over the messages printed by the task.
+// Pages are only fetched as the caller iterates.
+func (t *LuauTask) Logs(opts PageOptions) *Pager[LuauTaskLog] {
+	return newPager[LuauTaskLog](t.Client, t.Client.cloudEndpoint(t.Path+"/logs"), "luauExecutionSessionTaskLogs", nil, opts)
+}
+
+// ExecuteLuau runs a Luau script against the place and waits for it to finish, polling
+// the task with an increasing delay until it is done or the context is cancelled.
+//
+// It returns the finished task, whose Output holds the values returned by the script and
+// whose Logs holds what it printed. If the script fails, the task is returned along with its
+// *LuauTaskError; ErrLuauTaskCancelled is returned if the task was cancelled.
+// If the context is cancelled or a poll fails once the task has been created, the last known
+// state of the task is returned along with the error, so that its Path can still be used.
+// Returns an error if the script is empty, a HTTP request fails, or a response cannot be decoded.
+func (p *Place) ExecuteLuau(ctx context.Context, script string, opts LuauOptions) (*LuauTask, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+	if script == "" {
+		return nil, ErrNoScript
+	}
+
+	client := p.Universe.Client
+	path := p.cloudPath("")
+	if opts.VersionNumber > 0 {
+		path = p.cloudPath("/versions/" + strconv.FormatInt(opts.VersionNumber, 10))
+	}
+	requestBody := map[string]interface{}{"script": script}
+	if opts.Timeout > 0 {
+		requestBody["timeout"] = formatDuration(opts.Timeout)
+	}
+
+	resp, err := client.post(ctx, client.cloudEndpoint(path+"/luau-execution-session-tasks"), requestBody, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	task := &LuauTask{Client: client}
+	err = json.NewDecoder(resp.Body).Decode(task)
+	if err != nil {
+		return nil, err
+	}
+
+	interval := opts.PollInterval
+	if interval <= 0 {
+		interval = defaultLuauPollInterval
+	}
+	maxInterval := opts.MaxPollInterval
+	if maxInterval <= 0 {
+		maxInterval = defaultLuauMaxPollInterval
+	}
+	for !task.Done() {
+		if err := sleepContext(ctx, interval); err != nil {
+			return task, err
+		}
+		if interval *= 2; interval > maxInterval {
+			interval = maxInterval
+		}
+
+		polled, err := client.getLuauTask(ctx, task.Path)
+		if err != nil {
+			// Keep the last known task so the caller can still poll or cancel it by its path.
+			return task, err
+		}
+		task = polled
+	}
+
+	switch task.State {
+	case LuauTaskCancelled:
+		return task, ErrLuauTaskCancelled
+	case LuauTaskFailed:
+		if task.Error == nil {
+			task.Error = &LuauTaskError{Code: string(LuauTaskFailed)}
+		}
+		return task, task.Error
+	}
+
+	return task, nil
+}
+
+// getLuauTask fetches the current state of the Luau execution session task at the provided path.
+func (c *Client) getLuauTask(ctx context.Context, path string) (*LuauTask, error) {
+	resp, err := c.get(ctx, c.cloudEndpoint(path), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	task := &LuauTask{Client: c}
+	err = json.NewDecoder(resp.Body).Decode(task)
+	if err != nil {
+		return nil, err
+	}
+
+	return task, nil
+}
@@ -0,0 +1,101 @@
+package robloxgo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestPlace_ExecuteLuauPollsUntilDone(t *testing.T) {
+	taskPath := "universes/9/places/3/versions/12/luau-execution-sessions/s/tasks/t"
+	polls := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/cloud/v2/universes/9/places/3/versions/12/luau-execution-session-tasks":
+			var body map[string]string
+			json.NewDecoder(r.Body).Decode(&body)
+			if body["script"] != "return 1 + 1" || body["timeout"] != "30s" {
+				t.Errorf("unexpected body %v", body)
+			}
+			io.WriteString(w, `{"path":"`+taskPath+`","state":"QUEUED"}`)
+		case "/cloud/v2/" + taskPath:
+			polls++
+			if polls < 2 {
+				io.WriteString(w, `{"path":"`+taskPath+`","state":"PROCESSING"}`)
+				return
+			}
+			io.WriteString(w, `{"path":"`+taskPath+`","state":"COMPLETE","output":{"results":[2]}}`)
+		case "/cloud/v2/" + taskPath + "/logs":
+			io.WriteString(w, `{"luauExecutionSessionTaskLogs":[{"messages":["hello","world"]}]}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	task, err := client.Universe("9").Place("3").ExecuteLuau(context.Background(), "return 1 + 1", LuauOptions{
+		VersionNumber: 12,
+		Timeout:       30 * time.Second,
+		PollInterval:  time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if polls != 2 || len(task.Output.Results) != 1 || string(task.Output.Results[0]) != "2" {
+		t.Fatalf("unexpected task after %d polls: %+v", polls, task)
+	}
+
+	logs, err := task.Logs(PageOptions{}).All(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(logs) != 1 || logs[0].Messages[1] != "world" {
+		t.Fatalf("unexpected logs: %+v", logs)
+	}
+}
+
+func TestPlace_ExecuteLuauScriptError(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/cloud/v2/universes/9/places/3/luau-execution-session-tasks" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		io.WriteString(w, `{"path":"universes/9/places/3/luau-execution-sessions/s/tasks/t","state":"FAILED",
+			"error":{"code":"SCRIPT_ERROR","message":"attempt to index nil"}}`)
+	})
+
+	task, err := client.Universe("9").Place("3").ExecuteLuau(context.Background(), "error()", LuauOptions{})
+	var taskErr *LuauTaskError
+	if !errors.As(err, &taskErr) || taskErr.Code != "SCRIPT_ERROR" || task == nil {
+		t.Fatalf("expected a SCRIPT_ERROR task error, got %v", err)
+	}
+}
+
+func TestPlace_ExecuteLuauCancelledMidPoll(t *testing.T) {
+	taskPath := "universes/9/places/3/luau-execution-sessions/s/tasks/t"
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/cloud/v2/universes/9/places/3/luau-execution-session-tasks":
+			io.WriteString(w, `{"path":"`+taskPath+`","state":"QUEUED"}`)
+		case "/cloud/v2/" + taskPath:
+			cancel()
+			io.WriteString(w, `{"path":"`+taskPath+`","state":"PROCESSING"}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	task, err := client.Universe("9").Place("3").ExecuteLuau(ctx, "while true do end", LuauOptions{
+		PollInterval: time.Millisecond,
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if task == nil || task.Path != taskPath {
+		t.Fatalf("expected the task to be returned with its path, got %+v", task)
+	}
+}
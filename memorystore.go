@@ -0,0 +1,594 @@
+package robloxgo
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SortKey is the sort key of a memory store sorted map item, which is either a string or a number.
+// Create one with StringSortKey or NumericSortKey.
+type SortKey struct {
+	str     string
+	num     float64
+	numeric bool
+}
+
+// StringSortKey returns a SortKey ordering items by the provided string.
+func StringSortKey(key string) SortKey {
+	return SortKey{str: key}
+}
+
+// NumericSortKey returns a SortKey ordering items by the provided number.
+func NumericSortKey(key float64) SortKey {
+	return SortKey{num: key, numeric: true}
+}
+
+// IsNumeric reports whether the sort key is a number rather than a string.
+func (k SortKey) IsNumeric() bool {
+	return k.numeric
+}
+
+// String returns the string value of the sort key, or the formatted number of a numeric key.
+func (k SortKey) String() string {
+	if k.numeric {
+		return strconv.FormatFloat(k.num, 'f', -1, 64)
+	}
+
+	return k.str
+}
+
+// Number returns the numeric value of the sort key, or 0 for a string key.
+func (k SortKey) Number() float64 {
+	return k.num
+}
+
+// filterValue returns the sort key formatted for use in a list filter expression.
+func (k SortKey) filterValue() string {
+	if k.numeric {
+		return k.String()
+	}
+
+	return strconv.Quote(k.str)
+}
+
+// SortedMap is a handle to a memory store sorted map of a universe.
+type SortedMap struct {
+	// Name is the name of the sorted map.
+	Name string
+
+	// Universe is the universe the sorted map belongs to.
+	Universe *Universe
+}
+
+// SortedMapItem represents an item of a memory store sorted map.
+type SortedMapItem struct {
+	// Path is the Open Cloud resource path of the item.
+	Path string
+
+	// Key is the key of the item.
+	Key string
+
+	// Value is the JSON encoded value of the item. Use Decode to unmarshal it.
+	Value json.RawMessage
+
+	// Etag identifies the current version of the item, for use in update preconditions.
+	Etag string
+
+	// ExpiresAt is the timestamp of when the item expires.
+	ExpiresAt time.Time
+
+	// SortKey is the sort key of the item, or nil if it has none.
+	SortKey *SortKey
+}
+
+// UnmarshalJSON decodes an Open Cloud sorted map item, resolving its typed sort key.
+func (i *SortedMapItem) UnmarshalJSON(data []byte) error {
+	var item struct {
+		Path           string          `json:"path"`
+		ID             string          `json:"id"`
+		Value          json.RawMessage `json:"value"`
+		Etag           string          `json:"etag"`
+		ExpireTime     time.Time       `json:"expireTime"`
+		StringSortKey  *string         `json:"stringSortKey"`
+		NumericSortKey *float64        `json:"numericSortKey"`
+	}
+	err := json.Unmarshal(data, &item)
+	if err != nil {
+		return err
+	}
+
+	*i = SortedMapItem{
+		Path:      item.Path,
+		Key:       item.ID,
+		Value:     item.Value,
+		Etag:      item.Etag,
+		ExpiresAt: item.ExpireTime,
+	}
+	switch {
+	case item.StringSortKey != nil:
+		sortKey := StringSortKey(*item.StringSortKey)
+		i.SortKey = &sortKey
+	case item.NumericSortKey != nil:
+		sortKey := NumericSortKey(*item.NumericSortKey)
+		i.SortKey = &sortKey
+	}
+
+	return nil
+}
+
+// Decode unmarshals the item's JSON value into v.
+func (i *SortedMapItem) Decode(v interface{}) error {
+	return json.Unmarshal(i.Value, v)
+}
+
+// SortedMapItemOptions holds the optional fields sent when writing a sorted map item.
+type SortedMapItemOptions struct {
+	// TTL is how long the item is kept before it expires.
+	TTL time.Duration
+
+	// SortKey, if set, orders the item within the map.
+	SortKey *SortKey
+
+	// Etag, when set on an update, makes the write fail unless the item's current etag matches.
+	Etag string
+
+	// AllowMissing, when set on an update, creates the item if it does not exist.
+	AllowMissing bool
+}
+
+// body returns the request body for writing an item with the provided value and options.
+func (o SortedMapItemOptions) body(value interface{}) map[string]interface{} {
+	body := map[string]interface{}{"value": value}
+	if o.TTL > 0 {
+		body["ttl"] = formatDuration(o.TTL)
+	}
+	if o.SortKey != nil {
+		if o.SortKey.IsNumeric() {
+			body["numericSortKey"] = o.SortKey.Number()
+		} else {
+			body["stringSortKey"] = o.SortKey.String()
+		}
+	}
+	if o.Etag != "" {
+		body["etag"] = o.Etag
+	}
+
+	return body
+}
+
+// ListSortedMapOptions controls the order and range of the items returned by ListItems.
+// Every bound is exclusive.
+type ListSortedMapOptions struct {
+	PageOptions
+
+	// Descending lists the items from the highest sort key to the lowest, instead of lowest first.
+	Descending bool
+
+	// AfterKey and BeforeKey, if set, limit the items returned to keys after or before them.
+	AfterKey  string
+	BeforeKey string
+
+	// MinSortKey and MaxSortKey, if set, limit the items returned to sort keys above or below them.
+	MinSortKey *SortKey
+	MaxSortKey *SortKey
+
+	// Filter is an additional Open Cloud filter expression, joined to the bounds above with &&.
+	Filter string
+}
+
+// filter builds the list filter expression of the options.
+func (o ListSortedMapOptions) filter() string {
+	var filters []string
+	if o.AfterKey != "" {
+		filters = append(filters, "id > "+strconv.Quote(o.AfterKey))
+	}
+	if o.BeforeKey != "" {
+		filters = append(filters, "id < "+strconv.Quote(o.BeforeKey))
+	}
+	if o.MinSortKey != nil {
+		filters = append(filters, "sortKey > "+o.MinSortKey.filterValue())
+	}
+	if o.MaxSortKey != nil {
+		filters = append(filters, "sortKey < "+o.MaxSortKey.filterValue())
+	}
+	if o.Filter != "" {
+		filters = append(filters, o.Filter)
+	}
+
+	return strings.Join(filters, " && ")
+}
+
+// SortedMap returns a handle to the memory store sorted map with the provided name.
+//
+// No request is made until one of the handle's methods is called.
+func (u *Universe) SortedMap(name string) *SortedMap {
+	return &SortedMap{
+		Name:     name,
+		Universe: u,
+	}
+}
+
+// itemsURL returns the URL of the sorted map's items collection, followed by the provided suffix.
+func (m *SortedMap) itemsURL(suffix string) string {
+	path := "/memory-store/sorted-maps/" + escapePathSegment(m.Name) + "/items" + suffix
+
+	return m.Universe.Client.cloudEndpoint(m.Universe.cloudPath(path))
+}
+
+// validate checks that the sorted map handle and item key are usable.
+func (m *SortedMap) validate(key string) error {
+	if m.Universe.ID == "" {
+		return ErrNoUniverseID
+	}
+	if m.Name == "" {
+		return ErrNoMemoryStoreName
+	}
+	if key == "" {
+		return ErrNoEntryKey
+	}
+
+	return nil
+}
+
+// ListItems returns a Pager over the items of the sorted map, in sort key order and optionally
+// limited to a range of keys or sort keys. Pages are only fetched as the caller iterates.
+func (m *SortedMap) ListItems(opts ListSortedMapOptions) *Pager[SortedMapItem] {
+	var query []queryParam
+	if opts.Descending {
+		query = append(query, queryParam{Key: "orderBy", Value: "desc"})
+	}
+	if filter := opts.filter(); filter != "" {
+		query = append(query, queryParam{Key: "filter", Value: filter})
+	}
+
+	return newPager[SortedMapItem](m.Universe.Client, m.itemsURL(""), "memoryStoreSortedMapItems", query, opts.PageOptions)
+}
+
+// GetItem retrieves an item of the sorted map.
+//
+// Returns ErrNotFound (through errors.Is) if the item does not exist or has expired.
+// Returns an error if the key is empty, the HTTP request fails, or the response cannot be decoded.
+func (m *SortedMap) GetItem(key string) (*SortedMapItem, error) {
+	return m.GetItemContext(context.Background(), key)
+}
+
+// GetItemContext is like GetItem but uses the provided context for the request.
+func (m *SortedMap) GetItemContext(ctx context.Context, key string) (*SortedMapItem, error) {
+	if err := m.validate(key); err != nil {
+		return nil, err
+	}
+
+	resp, err := m.Universe.Client.get(ctx, m.itemsURL("/"+escapePathSegment(key)), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	item := &SortedMapItem{}
+	err = json.NewDecoder(resp.Body).Decode(item)
+	if err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// CreateItem creates a new item in the sorted map with the provided JSON serializable value,
+// expiring after opts.TTL.
+//
+// Returns ErrConflict (through errors.Is) if the item already exists.
+// Returns an error if the key is empty, the HTTP request fails, or the response cannot be decoded.
+func (m *SortedMap) CreateItem(key string, value interface{}, opts SortedMapItemOptions) (*SortedMapItem, error) {
+	return m.CreateItemContext(context.Background(), key, value, opts)
+}
+
+// CreateItemContext is like CreateItem but uses the provided context for the request.
+func (m *SortedMap) CreateItemContext(ctx context.Context, key string, value interface{}, opts SortedMapItemOptions) (*SortedMapItem, error) {
+	if err := m.validate(key); err != nil {
+		return nil, err
+	}
+
+	resp, err := m.Universe.Client.post(ctx, m.itemsURL(""), opts.body(value), nil, []queryParam{{Key: "id", Value: key}})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	item := &SortedMapItem{}
+	err = json.NewDecoder(resp.Body).Decode(item)
+	if err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// UpdateItem replaces the value of a sorted map item with the provided JSON serializable value,
+// resetting its expiry to opts.TTL.
+//
+// Set opts.Etag to only update the item if it has not changed since it was read,
+// and opts.AllowMissing to create the item if it does not exist.
+// Returns an error if the key is empty, the HTTP request fails, or the response cannot be decoded.
+func (m *SortedMap) UpdateItem(key string, value interface{}, opts SortedMapItemOptions) (*SortedMapItem, error) {
+	return m.UpdateItemContext(context.Background(), key, value, opts)
+}
+
+// UpdateItemContext is like UpdateItem but uses the provided context for the request.
+func (m *SortedMap) UpdateItemContext(ctx context.Context, key string, value interface{}, opts SortedMapItemOptions) (*SortedMapItem, error) {
+	if err := m.validate(key); err != nil {
+		return nil, err
+	}
+
+	var query []queryParam
+	if opts.AllowMissing {
+		query = append(query, queryParam{Key: "allowMissing", Value: "true"})
+	}
+	resp, err := m.Universe.Client.patch(ctx, m.itemsURL("/"+escapePathSegment(key)), opts.body(value), nil, query)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	item := &SortedMapItem{}
+	err = json.NewDecoder(resp.Body).Decode(item)
+	if err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// DeleteItem deletes an item from the sorted map.
+//
+// Returns true if the item was successfully deleted.
+// Returns an error if the key is empty or the HTTP request fails.
+func (m *SortedMap) DeleteItem(key string) (bool, error) {
+	return m.DeleteItemContext(context.Background(), key)
+}
+
+// DeleteItemContext is like DeleteItem but uses the provided context for the request.
+func (m *SortedMap) DeleteItemContext(ctx context.Context, key string) (bool, error) {
+	if err := m.validate(key); err != nil {
+		return false, err
+	}
+
+	return m.Universe.Client.delete(ctx, m.itemsURL("/"+escapePathSegment(key)), nil)
+}
+
+// Queue is a handle to a memory store queue of a universe.
+type Queue struct {
+	// Name is the name of the queue.
+	Name string
+
+	// Universe is the universe the queue belongs to.
+	Universe *Universe
+}
+
+// QueueItem represents an item added to a memory store queue.
+type QueueItem struct {
+	// Path is the Open Cloud resource path of the item.
+	Path string `json:"path"`
+
+	// Data is the JSON encoded data of the item.
+	Data json.RawMessage `json:"data"`
+
+	// Priority is the priority of the item; items with a higher priority are read first.
+	Priority float64 `json:"priority"`
+
+	// ExpiresAt is the timestamp of when the item expires.
+	ExpiresAt time.Time `json:"expireTime"`
+}
+
+// QueueRead is a batch of items read from a memory store queue.
+//
+// The items stay invisible to other readers for the read's invisibility window,
+// and are only removed from the queue once the read is discarded.
+type QueueRead struct {
+	// ID identifies the read, for use with Discard.
+	ID string `json:"id"`
+
+	// Items holds the JSON encoded data of every item read.
+	Items []json.RawMessage `json:"data"`
+}
+
+// ReadOptions controls how many items Read returns and how long they are hidden from other readers.
+type ReadOptions struct {
+	// Count is the number of items to read. Defaults to 1.
+	Count int
+
+	// AllOrNothing, if set, returns no items unless Count items are available.
+	AllOrNothing bool
+
+	// InvisibilityWindow is how long the items are hidden from other readers before they
+	// become readable again, unless the read is discarded first. Defaults to 30 seconds.
+	InvisibilityWindow time.Duration
+}
+
+// ConsumeOptions controls the reads made by Consume.
+type ConsumeOptions struct {
+	ReadOptions
+
+	// PollInterval is how long Consume waits before reading again when the queue is empty.
+	// Defaults to 1 second.
+	PollInterval time.Duration
+}
+
+// QueueHandler processes a batch of items read from a queue by Consume.
+// Returning nil discards the read; returning an error leaves the items to reappear
+// once the read's invisibility window passes.
+type QueueHandler func(ctx context.Context, read *QueueRead) error
+
+// Queue returns a handle to the memory store queue with the provided name.
+//
+// No request is made until one of the handle's methods is called.
+func (u *Universe) Queue(name string) *Queue {
+	return &Queue{
+		Name:     name,
+		Universe: u,
+	}
+}
+
+// itemsURL returns the URL of the queue's items collection, followed by the provided suffix.
+func (q *Queue) itemsURL(suffix string) string {
+	path := "/memory-store/queues/" + escapePathSegment(q.Name) + "/items" + suffix
+
+	return q.Universe.Client.cloudEndpoint(q.Universe.cloudPath(path))
+}
+
+// validate checks that the queue handle is usable.
+func (q *Queue) validate() error {
+	if q.Universe.ID == "" {
+		return ErrNoUniverseID
+	}
+	if q.Name == "" {
+		return ErrNoMemoryStoreName
+	}
+
+	return nil
+}
+
+// Enqueue adds an item with the provided JSON serializable data to the queue.
+// Items with a higher priority are read first, and the item expires after ttl.
+//
+// Returns an error if the HTTP request fails or the response cannot be decoded.
+func (q *Queue) Enqueue(data interface{}, priority float64, ttl time.Duration) (*QueueItem, error) {
+	return q.EnqueueContext(context.Background(), data, priority, ttl)
+}
+
+// EnqueueContext is like Enqueue but uses the provided context for the request.
+func (q *Queue) EnqueueContext(ctx context.Context, data interface{}, priority float64, ttl time.Duration) (*QueueItem, error) {
+	if err := q.validate(); err != nil {
+		return nil, err
+	}
+
+	requestBody := map[string]interface{}{"data": data, "priority": priority}
+	if ttl > 0 {
+		requestBody["ttl"] = formatDuration(ttl)
+	}
+	resp, err := q.Universe.Client.post(ctx, q.itemsURL(""), requestBody, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	item := &QueueItem{}
+	err = json.NewDecoder(resp.Body).Decode(item)
+	if err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// Read reads items from the queue, hiding them from other readers for the invisibility window.
+// Pass the returned read's ID to Discard once the items have been processed.
+//
+// The read has no ID and no items if the queue is empty.
+// Returns an error if the HTTP request fails or the response cannot be decoded.
+func (q *Queue) Read(opts ReadOptions) (*QueueRead, error) {
+	return q.ReadContext(context.Background(), opts)
+}
+
+// ReadContext is like Read but uses the provided context for the request.
+func (q *Queue) ReadContext(ctx context.Context, opts ReadOptions) (*QueueRead, error) {
+	if err := q.validate(); err != nil {
+		return nil, err
+	}
+
+	count := opts.Count
+	if count <= 0 {
+		count = 1
+	}
+	query := []queryParam{
+		{Key: "count", Value: strconv.Itoa(count)},
+		{Key: "allOrNothing", Value: strconv.FormatBool(opts.AllOrNothing)},
+	}
+	if opts.InvisibilityWindow > 0 {
+		query = append(query, queryParam{Key: "invisibilityWindow", Value: formatDuration(opts.InvisibilityWindow)})
+	}
+	resp, err := q.Universe.Client.get(ctx, q.itemsURL(":read"), nil, query)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	read := &QueueRead{}
+	err = json.NewDecoder(resp.Body).Decode(read)
+	if err != nil {
+		return nil, err
+	}
+
+	return read, nil
+}
+
+// Discard permanently removes the items of a read from the queue.
+//
+// Returns an error if the read ID is empty or the HTTP request fails.
+func (q *Queue) Discard(readID string) error {
+	return q.DiscardContext(context.Background(), readID)
+}
+
+// DiscardContext is like Discard but uses the provided context for the request.
+func (q *Queue) DiscardContext(ctx context.Context, readID string) error {
+	if err := q.validate(); err != nil {
+		return err
+	}
+	if readID == "" {
+		return ErrNoReadID
+	}
+
+	requestBody := map[string]interface{}{"readId": readID}
+	resp, err := q.Universe.Client.postIdempotent(ctx, q.itemsURL(":discard"), requestBody, nil, nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// Consume reads items from the queue until the context is cancelled, passing each read
+// to handler and discarding it only once handler returns nil. A read whose handler fails
+// is left for its items to reappear after the invisibility window, and the error is logged.
+// When the queue is empty, Consume waits opts.PollInterval before reading again.
+//
+// Returns the context error once the context is done, or the first error from a read or discard.
+func (q *Queue) Consume(ctx context.Context, handler QueueHandler, opts ConsumeOptions) error {
+	pollInterval := opts.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = time.Second
+	}
+
+	for {
+		read, err := q.ReadContext(ctx, opts.ReadOptions)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+
+		if len(read.Items) == 0 {
+			if err := sleepContext(ctx, pollInterval); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := handler(ctx, read); err != nil {
+			q.Universe.Client.logf("robloxgo: queue %s handler failed for read %s: %v", q.Name, read.ID, err)
+			continue
+		}
+
+		err = q.DiscardContext(ctx, read.ID)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+	}
+}
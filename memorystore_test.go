@@ -0,0 +1,149 @@
+package robloxgo
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestSortedMap_ListItemsRange(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/cloud/v2/universes/1/memory-store/sorted-maps/Lobby/items" {
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+		query := r.URL.Query()
+		if query.Get("orderBy") != "desc" || query.Get("filter") != `id > "a" && sortKey > 10 && sortKey < "z"` {
+			t.Errorf("unexpected query %v", query)
+		}
+		io.WriteString(w, `{"memoryStoreSortedMapItems":[
+			{"id":"b","value":1,"numericSortKey":20,"expireTime":"2025-01-01T00:00:00Z"},
+			{"id":"c","value":2,"stringSortKey":"m"}
+		]}`)
+	})
+
+	min, max := NumericSortKey(10), StringSortKey("z")
+	items, err := client.Universe("1").SortedMap("Lobby").ListItems(ListSortedMapOptions{
+		Descending: true,
+		AfterKey:   "a",
+		MinSortKey: &min,
+		MaxSortKey: &max,
+	}).All(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(items) != 2 || !items[0].SortKey.IsNumeric() || items[0].SortKey.Number() != 20 {
+		t.Fatalf("unexpected items: %+v", items)
+	}
+	if items[1].SortKey.IsNumeric() || items[1].SortKey.String() != "m" || !items[1].ExpiresAt.IsZero() {
+		t.Fatalf("unexpected item: %+v", items[1])
+	}
+}
+
+func TestSortedMap_CreateItem(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Query().Get("id") != "player:1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["ttl"] != "90s" || body["numericSortKey"].(float64) != 1500 || body["stringSortKey"] != nil {
+			t.Errorf("unexpected body %v", body)
+		}
+		io.WriteString(w, `{"id":"player:1","value":{"elo":1500},"etag":"e1","numericSortKey":1500}`)
+	})
+
+	sortKey := NumericSortKey(1500)
+	item, err := client.Universe("1").SortedMap("Lobby").CreateItem("player:1", map[string]int{"elo": 1500}, SortedMapItemOptions{
+		TTL:     90 * time.Second,
+		SortKey: &sortKey,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var value struct {
+		Elo int `json:"elo"`
+	}
+	if err := item.Decode(&value); err != nil || value.Elo != 1500 || item.Etag != "e1" {
+		t.Fatalf("unexpected item %+v: %v", item, err)
+	}
+}
+
+func TestSortedMap_Validate(t *testing.T) {
+	client, _ := Create("key")
+	if _, err := client.Universe("1").SortedMap("").GetItem("a"); err != ErrNoMemoryStoreName {
+		t.Fatalf("expected ErrNoMemoryStoreName, got %v", err)
+	}
+	if _, err := client.Universe("1").SortedMap("Lobby").DeleteItem(""); err != ErrNoEntryKey {
+		t.Fatalf("expected ErrNoEntryKey, got %v", err)
+	}
+}
+
+func TestQueue_ReadQuery(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/cloud/v2/universes/1/memory-store/queues/Jobs/items:read" {
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+		query := r.URL.Query()
+		if query.Get("count") != "5" || query.Get("allOrNothing") != "true" || query.Get("invisibilityWindow") != "45s" {
+			t.Errorf("unexpected query %v", query)
+		}
+		io.WriteString(w, `{"data":[{"job":1},{"job":2}],"id":"read-1"}`)
+	})
+
+	read, err := client.Universe("1").Queue("Jobs").Read(ReadOptions{Count: 5, AllOrNothing: true, InvisibilityWindow: 45 * time.Second})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if read.ID != "read-1" || len(read.Items) != 2 {
+		t.Fatalf("unexpected read: %+v", read)
+	}
+}
+
+func TestQueue_ConsumeDiscardsOnlyHandledReads(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	reads := []string{
+		`{"data":[{"job":1}],"id":"fails"}`,
+		`{"data":[]}`,
+		`{"data":[{"job":2}],"id":"succeeds"}`,
+	}
+	var discarded []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/cloud/v2/universes/1/memory-store/queues/Jobs/items:read":
+			if len(reads) == 0 {
+				t.Errorf("unexpected read after cancellation")
+				io.WriteString(w, `{}`)
+				return
+			}
+			io.WriteString(w, reads[0])
+			reads = reads[1:]
+		case "/cloud/v2/universes/1/memory-store/queues/Jobs/items:discard":
+			var body map[string]string
+			json.NewDecoder(r.Body).Decode(&body)
+			discarded = append(discarded, body["readId"])
+			cancel()
+			io.WriteString(w, `{}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	err := client.Universe("1").Queue("Jobs").Consume(ctx, func(ctx context.Context, read *QueueRead) error {
+		if read.ID == "fails" {
+			return io.ErrUnexpectedEOF
+		}
+		return nil
+	}, ConsumeOptions{PollInterval: time.Millisecond})
+	if err != context.Canceled {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if len(discarded) != 1 || discarded[0] != "succeeds" {
+		t.Fatalf("unexpected discards: %v", discarded)
+	}
+}
@@ -0,0 +1,51 @@
+package robloxgo
+
+import (
+	"context"
+	"fmt"
+	"unicode/utf8"
+)
+
+// Limits that Roblox applies to MessagingService messages published through Open Cloud.
+const (
+	// MaxTopicLength is the maximum length of a topic, in characters.
+	MaxTopicLength = 80
+
+	// MaxMessageSize is the maximum size of a message, in bytes.
+	MaxMessageSize = 1024
+)
+
+// PublishMessage publishes a message to a MessagingService topic, delivering it to every
+// live server of the universe subscribed to the topic.
+//
+// The topic and message are checked against MaxTopicLength and MaxMessageSize before the
+// request is sent. Returns an error wrapping ErrNoTopic, ErrTopicTooLong or ErrMessageTooLarge
+// if they are not within the limits, or an error if the HTTP request fails.
+func (u *Universe) PublishMessage(topic string, message string) error {
+	return u.PublishMessageContext(context.Background(), topic, message)
+}
+
+// PublishMessageContext is like PublishMessage but uses the provided context for the request.
+func (u *Universe) PublishMessageContext(ctx context.Context, topic string, message string) error {
+	if u.ID == "" {
+		return ErrNoUniverseID
+	}
+	if topic == "" {
+		return ErrNoTopic
+	}
+	if length := utf8.RuneCountInString(topic); length > MaxTopicLength {
+		return fmt.Errorf("%w: %d characters, the limit is %d", ErrTopicTooLong, length, MaxTopicLength)
+	}
+	if size := len(message); size > MaxMessageSize {
+		return fmt.Errorf("%w: %d bytes, the limit is %d", ErrMessageTooLarge, size, MaxMessageSize)
+	}
+
+	requestBody := map[string]interface{}{"topic": topic, "message": message}
+	resp, err := u.Client.post(ctx, u.Client.cloudEndpoint(u.cloudPath(":publishMessage")), requestBody, nil, nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
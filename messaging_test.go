@@ -0,0 +1,44 @@
+package robloxgo
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestUniverse_PublishMessage(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/cloud/v2/universes/1:publishMessage" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var body map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["topic"] != "shutdown" || body["message"] != "in 5 minutes" {
+			t.Errorf("unexpected body %v", body)
+		}
+		w.Write([]byte(`{}`))
+	})
+
+	if err := client.Universe("1").PublishMessage("shutdown", "in 5 minutes"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestUniverse_PublishMessageLimits(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+	universe := client.Universe("1")
+
+	if err := universe.PublishMessage("", "hi"); err != ErrNoTopic {
+		t.Fatalf("expected ErrNoTopic, got %v", err)
+	}
+	if err := universe.PublishMessage(strings.Repeat("é", MaxTopicLength+1), "hi"); !errors.Is(err, ErrTopicTooLong) {
+		t.Fatalf("expected ErrTopicTooLong, got %v", err)
+	}
+	if err := universe.PublishMessage("topic", strings.Repeat("a", MaxMessageSize+1)); !errors.Is(err, ErrMessageTooLarge) {
+		t.Fatalf("expected ErrMessageTooLarge, got %v", err)
+	}
+}
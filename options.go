@@ -0,0 +1,105 @@
+package robloxgo
+
+import (
+	"net/http"
+	"time"
+)
+
+// Option configures a Client created with Create.
+type Option func(*Client)
+
+// Logger is the interface used by a Client to report retried requests.
+// It is satisfied by *log.Logger.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// WithHTTPClient sets the http.Client used to send requests.
+//
+// The client is copied, and its transport is wrapped so that the API key is still
+// attached to every request. A nil transport falls back to http.DefaultTransport.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.client = httpClient
+	}
+}
+
+// WithTransport sets the http.RoundTripper used to send requests, such as a proxy
+// or instrumented transport. It takes precedence over the transport of WithHTTPClient.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(c *Client) {
+		c.transport = transport
+	}
+}
+
+// WithTimeout sets the time limit for each HTTP request made by the client,
+// including every retry attempt on its own.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.timeout = timeout
+	}
+}
+
+// WithBaseURL overrides the base URL of an API family, such as FamilyCloud
+// or FamilyGroups. This can be used to point the client at a proxy or a local fake server.
+func WithBaseURL(family APIFamily, baseURL string) Option {
+	return func(c *Client) {
+		c.baseURLs[family] = baseURL
+	}
+}
+
+// WithUserAgentSuffix appends the provided text to the User-Agent header sent with every request.
+func WithUserAgentSuffix(suffix string) Option {
+	return func(c *Client) {
+		c.userAgent = robloxGoUserAgent + " " + suffix
+	}
+}
+
+// WithLogger sets the Logger the client uses to report retried requests.
+func WithLogger(logger Logger) Option {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
+
+// WithRetryPolicy sets the RetryPolicy applied to every request made by the client.
+//
+// Use NoRetryPolicy to disable retries.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retryPolicy = policy
+	}
+}
+
+// WithRateLimits sets the budget of one or more rate limit buckets.
+//
+// Buckets that are not provided keep their DefaultRateLimits budget.
+func WithRateLimits(limits map[RateLimitBucket]RateLimit) Option {
+	return func(c *Client) {
+		c.limiter = NewRateLimiter(limits)
+	}
+}
+
+// WithRateLimiter sets the RateLimiter used by the client, allowing several
+// clients to share one budget. Passing nil disables client side rate limiting.
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(c *Client) {
+		c.limiter = limiter
+	}
+}
+
+// WithCache sets the Cache used to store users, groups, roles and memberships.
+// Passing nil disables caching.
+func WithCache(cache Cache) Option {
+	return func(c *Client) {
+		c.cache = cache
+	}
+}
+
+// WithCacheTTL sets how long a type of resource is cached for.
+// A ttl of 0 disables caching of that resource.
+func WithCacheTTL(resource CacheResource, ttl time.Duration) Option {
+	return func(c *Client) {
+		c.cacheTTLs[resource] = ttl
+	}
+}
@@ -0,0 +1,287 @@
+package robloxgo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// OrderedDataStore is a handle to an ordered data store of a universe within a scope.
+// Ordered data stores hold integer values and can be listed in value order,
+// which makes them suitable for leaderboards.
+type OrderedDataStore struct {
+	// Name is the name of the ordered data store.
+	Name string
+
+	// Scope is the scope of the ordered data store. An empty scope uses the default "global" scope.
+	Scope string
+
+	// Universe is the universe the ordered data store belongs to.
+	Universe *Universe
+}
+
+// OrderedDataStoreEntry represents an entry of an ordered data store.
+type OrderedDataStoreEntry struct {
+	// Path is the Open Cloud resource path of the entry.
+	Path string
+
+	// Key is the key of the entry.
+	Key string
+
+	// Value is the integer value of the entry.
+	Value int64
+}
+
+// UnmarshalJSON decodes an Open Cloud ordered data store entry into its typed fields.
+func (e *OrderedDataStoreEntry) UnmarshalJSON(data []byte) error {
+	var entry struct {
+		Path  string      `json:"path"`
+		ID    string      `json:"id"`
+		Value json.Number `json:"value"`
+	}
+	err := json.Unmarshal(data, &entry)
+	if err != nil {
+		return err
+	}
+
+	*e = OrderedDataStoreEntry{
+		Path: entry.Path,
+		Key:  entry.ID,
+	}
+	if entry.Value == "" {
+		return nil
+	}
+	e.Value, err = strconv.ParseInt(entry.Value.String(), 10, 64)
+	if err != nil {
+		// Integral values may still be encoded as floats, such as 50.0 or 1e3.
+		value, floatErr := entry.Value.Float64()
+		if floatErr != nil {
+			return err
+		}
+		if value != math.Trunc(value) || value < math.MinInt64 || value >= math.MaxInt64 {
+			return fmt.Errorf("ordered data store entry %q has non-integral value %s", entry.ID, entry.Value)
+		}
+		e.Value = int64(value)
+	}
+
+	return nil
+}
+
+// ListOrderedEntriesOptions controls the order and range of the entries returned by ListEntries.
+type ListOrderedEntriesOptions struct {
+	PageOptions
+
+	// Descending lists the entries from the highest value to the lowest, instead of lowest first.
+	Descending bool
+
+	// Min, if set, limits the entries returned to values greater than or equal to it.
+	Min *int64
+
+	// Max, if set, limits the entries returned to values less than or equal to it.
+	Max *int64
+}
+
+// OrderedDataStore returns a handle to the ordered data store with the provided name and scope.
+// An empty scope uses the default "global" scope.
+//
+// No request is made until one of the handle's methods is called.
+func (u *Universe) OrderedDataStore(name string, scope string) *OrderedDataStore {
+	return &OrderedDataStore{
+		Name:     name,
+		Scope:    scope,
+		Universe: u,
+	}
+}
+
+// entriesURL returns the URL of the ordered data store's entries collection, followed by the provided suffix.
+func (o *OrderedDataStore) entriesURL(suffix string) string {
+	scope := o.Scope
+	if scope == "" {
+		scope = "global"
+	}
+	path := "/ordered-data-stores/" + escapePathSegment(o.Name) + "/scopes/" + escapePathSegment(scope) + "/entries"
+
+	return o.Universe.Client.cloudEndpoint(o.Universe.cloudPath(path + suffix))
+}
+
+// entryURL returns the URL of a single entry, followed by the provided suffix.
+func (o *OrderedDataStore) entryURL(key string, suffix string) string {
+	return o.entriesURL("/" + escapePathSegment(key) + suffix)
+}
+
+// validate checks that the ordered data store handle and entry key are usable.
+func (o *OrderedDataStore) validate(key string) error {
+	if o.Universe.ID == "" {
+		return ErrNoUniverseID
+	}
+	if o.Name == "" {
+		return ErrNoDataStoreName
+	}
+	if key == "" {
+		return ErrNoEntryKey
+	}
+
+	return nil
+}
+
+// ListEntries returns a Pager over the entries of the ordered data store, in value order
+// and optionally limited to a range of values. Pages are only fetched as the caller iterates.
+func (o *OrderedDataStore) ListEntries(opts ListOrderedEntriesOptions) *Pager[OrderedDataStoreEntry] {
+	var query []queryParam
+	if opts.Descending {
+		query = append(query, queryParam{Key: "orderBy", Value: "value desc"})
+	}
+
+	var filters []string
+	if opts.Min != nil {
+		filters = append(filters, "entry >= "+strconv.FormatInt(*opts.Min, 10))
+	}
+	if opts.Max != nil {
+		filters = append(filters, "entry <= "+strconv.FormatInt(*opts.Max, 10))
+	}
+	if len(filters) > 0 {
+		query = append(query, queryParam{Key: "filter", Value: strings.Join(filters, " && ")})
+	}
+
+	return newPager[OrderedDataStoreEntry](o.Universe.Client, o.entriesURL(""), "orderedDataStoreEntries", query, opts.PageOptions)
+}
+
+// GetEntry retrieves an entry of the ordered data store.
+//
+// Returns ErrNotFound (through errors.Is) if the entry does not exist.
+// Returns an error if the key is empty, the HTTP request fails, or the response cannot be decoded.
+func (o *OrderedDataStore) GetEntry(key string) (*OrderedDataStoreEntry, error) {
+	return o.GetEntryContext(context.Background(), key)
+}
+
+// GetEntryContext is like GetEntry but uses the provided context for the request.
+func (o *OrderedDataStore) GetEntryContext(ctx context.Context, key string) (*OrderedDataStoreEntry, error) {
+	if err := o.validate(key); err != nil {
+		return nil, err
+	}
+
+	resp, err := o.Universe.Client.get(ctx, o.entryURL(key, ""), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	entry := &OrderedDataStoreEntry{}
+	err = json.NewDecoder(resp.Body).Decode(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// CreateEntry creates a new entry in the ordered data store with the provided value.
+//
+// Returns ErrConflict (through errors.Is) if the entry already exists.
+// Returns an error if the key is empty, the HTTP request fails, or the response cannot be decoded.
+func (o *OrderedDataStore) CreateEntry(key string, value int64) (*OrderedDataStoreEntry, error) {
+	return o.CreateEntryContext(context.Background(), key, value)
+}
+
+// CreateEntryContext is like CreateEntry but uses the provided context for the request.
+func (o *OrderedDataStore) CreateEntryContext(ctx context.Context, key string, value int64) (*OrderedDataStoreEntry, error) {
+	if err := o.validate(key); err != nil {
+		return nil, err
+	}
+
+	requestBody := map[string]interface{}{"value": value}
+	resp, err := o.Universe.Client.post(ctx, o.entriesURL(""), requestBody, nil, []queryParam{{Key: "id", Value: key}})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	entry := &OrderedDataStoreEntry{}
+	err = json.NewDecoder(resp.Body).Decode(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// UpdateEntry sets the value of an entry in the ordered data store.
+// If allowMissing is true, the entry is created if it does not exist.
+//
+// Returns an error if the key is empty, the HTTP request fails, or the response cannot be decoded.
+func (o *OrderedDataStore) UpdateEntry(key string, value int64, allowMissing bool) (*OrderedDataStoreEntry, error) {
+	return o.UpdateEntryContext(context.Background(), key, value, allowMissing)
+}
+
+// UpdateEntryContext is like UpdateEntry but uses the provided context for the request.
+func (o *OrderedDataStore) UpdateEntryContext(ctx context.Context, key string, value int64, allowMissing bool) (*OrderedDataStoreEntry, error) {
+	if err := o.validate(key); err != nil {
+		return nil, err
+	}
+
+	requestBody := map[string]interface{}{"value": value}
+	query := []queryParam{{Key: "allowMissing", Value: strconv.FormatBool(allowMissing)}}
+	resp, err := o.Universe.Client.patch(ctx, o.entryURL(key, ""), requestBody, nil, query)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	entry := &OrderedDataStoreEntry{}
+	err = json.NewDecoder(resp.Body).Decode(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// IncrementEntry atomically adds amount to the value of an entry in the ordered data store,
+// creating the entry if it does not exist.
+//
+// Returns an error if the key is empty, the HTTP request fails, or the response cannot be decoded.
+func (o *OrderedDataStore) IncrementEntry(key string, amount int64) (*OrderedDataStoreEntry, error) {
+	return o.IncrementEntryContext(context.Background(), key, amount)
+}
+
+// IncrementEntryContext is like IncrementEntry but uses the provided context for the request.
+func (o *OrderedDataStore) IncrementEntryContext(ctx context.Context, key string, amount int64) (*OrderedDataStoreEntry, error) {
+	if err := o.validate(key); err != nil {
+		return nil, err
+	}
+
+	requestBody := map[string]interface{}{"amount": amount}
+	resp, err := o.Universe.Client.post(ctx, o.entryURL(key, ":increment"), requestBody, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	entry := &OrderedDataStoreEntry{}
+	err = json.NewDecoder(resp.Body).Decode(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// DeleteEntry deletes an entry from the ordered data store.
+//
+// Returns true if the entry was successfully deleted.
+// Returns an error if the key is empty or the HTTP request fails.
+func (o *OrderedDataStore) DeleteEntry(key string) (bool, error) {
+	return o.DeleteEntryContext(context.Background(), key)
+}
+
+// DeleteEntryContext is like DeleteEntry but uses the provided context for the request.
+func (o *OrderedDataStore) DeleteEntryContext(ctx context.Context, key string) (bool, error) {
+	if err := o.validate(key); err != nil {
+		return false, err
+	}
+
+	return o.Universe.Client.delete(ctx, o.entryURL(key, ""), nil)
+}
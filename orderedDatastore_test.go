@@ -0,0 +1,139 @@
+package robloxgo
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestOrderedDataStore_ListEntries(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/cloud/v2/universes/1/ordered-data-stores/Kills/scopes/global/entries" {
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+		query := r.URL.Query()
+		if query.Get("orderBy") != "value desc" || query.Get("filter") != "entry >= 10 && entry <= 50" {
+			t.Errorf("unexpected query %v", query)
+		}
+		io.WriteString(w, `{"orderedDataStoreEntries":[{"id":"a","value":50},{"id":"b","value":"12"}]}`)
+	})
+
+	min, max := int64(10), int64(50)
+	entries, err := client.Universe("1").OrderedDataStore("Kills", "").ListEntries(ListOrderedEntriesOptions{
+		Descending: true,
+		Min:        &min,
+		Max:        &max,
+	}).All(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(entries) != 2 || entries[0].Value != 50 || entries[1].Value != 12 {
+		t.Fatalf("unexpected entries: %+v", entries)
+	}
+}
+
+func TestOrderedDataStoreEntry_NonIntegralValue(t *testing.T) {
+	var entry OrderedDataStoreEntry
+	if err := json.Unmarshal([]byte(`{"id":"a","value":1e3}`), &entry); err != nil || entry.Value != 1000 {
+		t.Fatalf("unexpected entry %+v: %v", entry, err)
+	}
+	if err := json.Unmarshal([]byte(`{"id":"a","value":12.5}`), &entry); err == nil {
+		t.Fatalf("expected an error for a non-integral value, got %+v", entry)
+	}
+}
+
+func TestOrderedDataStore_EntryRequests(t *testing.T) {
+	const entries = "/cloud/v2/universes/1/ordered-data-stores/Kills/scopes/global/entries"
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		query  map[string]string
+		body   string
+		call   func(store *OrderedDataStore) error
+	}{
+		{
+			name:   "get",
+			method: http.MethodGet,
+			path:   entries + "/alice",
+			call: func(store *OrderedDataStore) error {
+				_, err := store.GetEntry("alice")
+				return err
+			},
+		},
+		{
+			name:   "create",
+			method: http.MethodPost,
+			path:   entries,
+			query:  map[string]string{"id": "alice"},
+			body:   `{"value":5}`,
+			call: func(store *OrderedDataStore) error {
+				_, err := store.CreateEntry("alice", 5)
+				return err
+			},
+		},
+		{
+			name:   "update",
+			method: http.MethodPatch,
+			path:   entries + "/alice",
+			query:  map[string]string{"allowMissing": "true"},
+			body:   `{"value":5}`,
+			call: func(store *OrderedDataStore) error {
+				_, err := store.UpdateEntry("alice", 5, true)
+				return err
+			},
+		},
+		{
+			name:   "increment",
+			method: http.MethodPost,
+			path:   entries + "/alice:increment",
+			body:   `{"amount":3}`,
+			call: func(store *OrderedDataStore) error {
+				_, err := store.IncrementEntry("alice", 3)
+				return err
+			},
+		},
+		{
+			name:   "delete",
+			method: http.MethodDelete,
+			path:   entries + "/alice",
+			call: func(store *OrderedDataStore) error {
+				_, err := store.DeleteEntry("alice")
+				return err
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != test.method || r.URL.Path != test.path {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				query := r.URL.Query()
+				for key, value := range test.query {
+					if query.Get(key) != value {
+						t.Errorf("expected query %s=%s, got %v", key, value, query)
+					}
+				}
+				if test.body != "" {
+					body, _ := io.ReadAll(r.Body)
+					var got, want interface{}
+					json.Unmarshal(body, &got)
+					json.Unmarshal([]byte(test.body), &want)
+					if !reflect.DeepEqual(got, want) {
+						t.Errorf("expected body %s, got %s", test.body, body)
+					}
+				}
+				io.WriteString(w, `{"path":"universes/1/ordered-data-stores/Kills/scopes/global/entries/alice","id":"alice","value":5}`)
+			})
+
+			if err := test.call(client.Universe("1").OrderedDataStore("Kills", "")); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package robloxgo
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+)
+
+// PageOptions controls how a Pager fetches pages from an Open Cloud list endpoint.
+type PageOptions struct {
+	// PageSize is the maximum number of items requested per page.
+	// A value of 0 uses the default page size of the endpoint.
+	PageSize int
+
+	// PageToken is the token of the page to start from. It is empty to start from the
+	// first page, or a value previously returned by Pager.PageToken to resume a crawl.
+	PageToken string
+}
+
+// Pager lazily iterates over the items of a paginated Open Cloud list endpoint.
+//
+// Pages are only requested when the caller asks for more items, so large result sets
+// never have to fit in memory. The token of the current position can be read with
+// PageToken and passed back through PageOptions to resume the iteration later.
+//
+// A Pager is not safe for concurrent use.
+type Pager[T any] struct {
+	client     *Client
+	methodURL  string
+	itemsField string
+	parameters []queryParam
+	pageSize   int
+
+	// prepare is applied to every page after it is decoded and before it is returned.
+	prepare func(ctx context.Context, items []T) ([]T, error)
+
+	// currentToken is the token the buffered page was fetched with, nextToken the token of the following page
+	currentToken string
+	nextToken    string
+	done         bool
+
+	buffer []T
+	index  int
+}
+
+// newPager returns a Pager over methodURL, decoding each page's items from the itemsField
+// of the response. The parameters are sent with every page request.
+func newPager[T any](client *Client, methodURL string, itemsField string, parameters []queryParam, opts PageOptions) *Pager[T] {
+	return &Pager[T]{
+		client:     client,
+		methodURL:  methodURL,
+		itemsField: itemsField,
+		parameters: parameters,
+		pageSize:   opts.PageSize,
+		nextToken:  opts.PageToken,
+	}
+}
+
+// NextPage fetches and returns the next page of items.
+//
+// Returns ErrNoMorePages once every page has been returned, or an error if the
+// HTTP request fails or the response cannot be decoded. A failed page can be
+// retried by calling NextPage again.
+func (p *Pager[T]) NextPage(ctx context.Context) ([]T, error) {
+	if p.done {
+		return nil, ErrNoMorePages
+	}
+
+	query := append([]queryParam{}, p.parameters...)
+	if p.pageSize > 0 {
+		query = append(query, queryParam{Key: "maxPageSize", Value: strconv.Itoa(p.pageSize)})
+	}
+	if p.nextToken != "" {
+		query = append(query, queryParam{Key: "pageToken", Value: p.nextToken})
+	}
+
+	resp, err := p.client.get(ctx, p.methodURL, nil, query)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var pageResponse map[string]json.RawMessage
+	err = json.NewDecoder(resp.Body).Decode(&pageResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []T
+	if raw, ok := pageResponse[p.itemsField]; ok {
+		err = json.Unmarshal(raw, &items)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var nextPageToken string
+	if raw, ok := pageResponse["nextPageToken"]; ok {
+		err = json.Unmarshal(raw, &nextPageToken)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if p.prepare != nil {
+		items, err = p.prepare(ctx, items)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	p.currentToken = p.nextToken
+	p.nextToken = nextPageToken
+	p.done = nextPageToken == ""
+	p.buffer = items
+	p.index = 0
+
+	return items, nil
+}
+
+// Next returns the next item, fetching a new page when the current one is exhausted.
+//
+// Returns ErrNoMorePages once every item has been returned.
+func (p *Pager[T]) Next(ctx context.Context) (T, error) {
+	for p.index >= len(p.buffer) {
+		if _, err := p.NextPage(ctx); err != nil {
+			var zero T
+			return zero, err
+		}
+	}
+
+	item := p.buffer[p.index]
+	p.index++
+
+	return item, nil
+}
+
+// Each calls fn for every remaining item, stopping at the first error returned by fn or by a page request.
+func (p *Pager[T]) Each(ctx context.Context, fn func(T) error) error {
+	for {
+		item, err := p.Next(ctx)
+		if err == ErrNoMorePages {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+}
+
+// All collects every remaining item into a slice.
+func (p *Pager[T]) All(ctx context.Context) ([]T, error) {
+	var items []T
+	err := p.Each(ctx, func(item T) error {
+		items = append(items, item)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// PageToken returns a token that can be passed through PageOptions to resume iteration.
+//
+// If items of the current page have not all been returned by Next yet, the token of the
+// current page is returned, so resuming may repeat some items but never skips any.
+// An empty token with Done reporting true means the iteration is complete.
+func (p *Pager[T]) PageToken() string {
+	if p.index < len(p.buffer) {
+		return p.currentToken
+	}
+
+	return p.nextToken
+}
+
+// Done reports whether every page has been fetched and every item returned.
+func (p *Pager[T]) Done() bool {
+	return p.done && p.index >= len(p.buffer)
+}
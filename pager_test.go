@@ -0,0 +1,71 @@
+package robloxgo
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+// pagedRolesHandler serves three pages of one role each, keyed by page token.
+func pagedRolesHandler(t *testing.T) http.HandlerFunc {
+	pages := map[string]string{
+		"":   `{"groupRoles":[{"id":"1","displayName":"Guest","rank":0}],"nextPageToken":"p2"}`,
+		"p2": `{"groupRoles":[{"id":"2","displayName":"Member","rank":1}],"nextPageToken":"p3"}`,
+		"p3": `{"groupRoles":[{"id":"3","displayName":"Owner","rank":255}],"nextPageToken":""}`,
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("maxPageSize") != "1" {
+			t.Errorf("expected maxPageSize 1, got %q", r.URL.Query().Get("maxPageSize"))
+		}
+		page, ok := pages[r.URL.Query().Get("pageToken")]
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(page))
+	}
+}
+
+func TestPager_All(t *testing.T) {
+	client := newTestClient(t, pagedRolesHandler(t))
+	group := &Group{ID: "7", Client: client}
+
+	roles, err := group.ListRoles(PageOptions{PageSize: 1}).All(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(roles) != 3 || roles[2].Name != "Owner" {
+		t.Fatalf("unexpected roles: %+v", roles)
+	}
+}
+
+func TestPager_ResumeFromToken(t *testing.T) {
+	client := newTestClient(t, pagedRolesHandler(t))
+	group := &Group{ID: "7", Client: client}
+	ctx := context.Background()
+
+	pager := group.ListRoles(PageOptions{PageSize: 1})
+	if _, err := pager.Next(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	token := pager.PageToken()
+	if token != "p2" {
+		t.Fatalf("expected token p2, got %q", token)
+	}
+
+	resumed := group.ListRoles(PageOptions{PageSize: 1, PageToken: token})
+	roles, err := resumed.All(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(roles) != 2 || roles[0].Name != "Member" {
+		t.Fatalf("unexpected roles: %+v", roles)
+	}
+	if !resumed.Done() {
+		t.Fatal("expected pager to be done")
+	}
+	if _, err := resumed.NextPage(ctx); err != ErrNoMorePages {
+		t.Fatalf("expected ErrNoMorePages, got %v", err)
+	}
+}
@@ -0,0 +1,112 @@
+package robloxgo
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Place is a handle to a place of a universe.
+type Place struct {
+	// ID is the unique identifier of the place.
+	ID string
+
+	// Universe is the universe the place belongs to.
+	Universe *Universe
+}
+
+// PlaceVersion represents a saved or published version of a place.
+type PlaceVersion struct {
+	// Path is the Open Cloud resource path of the version.
+	Path string
+
+	// VersionNumber is the number of the version, incremented with every save or publish.
+	VersionNumber int64
+
+	// CreatedAt is the timestamp of when the version was created.
+	CreatedAt time.Time
+}
+
+// UnmarshalJSON decodes an Open Cloud place version, reading its number from the resource path.
+func (v *PlaceVersion) UnmarshalJSON(data []byte) error {
+	var version struct {
+		Path       string    `json:"path"`
+		CreateTime time.Time `json:"createTime"`
+	}
+	err := json.Unmarshal(data, &version)
+	if err != nil {
+		return err
+	}
+
+	*v = PlaceVersion{
+		Path:      version.Path,
+		CreatedAt: version.CreateTime,
+	}
+	number := version.Path[strings.LastIndex(version.Path, "/")+1:]
+	if number != "" {
+		v.VersionNumber, err = strconv.ParseInt(number, 10, 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Place returns a handle to the place of the universe with the provided ID.
+//
+// No request is made until one of the handle's methods is called.
+func (u *Universe) Place(placeID string) *Place {
+	return &Place{
+		ID:       placeID,
+		Universe: u,
+	}
+}
+
+// cloudPath returns the Open Cloud resource path of the place, followed by the provided suffix.
+func (p *Place) cloudPath(suffix string) string {
+	return p.Universe.cloudPath("/places/" + p.ID + suffix)
+}
+
+// validate checks that the place handle is usable.
+func (p *Place) validate() error {
+	if p.Universe.ID == "" {
+		return ErrNoUniverseID
+	}
+	if p.ID == "" {
+		return ErrNoPlaceID
+	}
+
+	return nil
+}
+
+// RestartServers shuts down every running server of the universe so that players
+// rejoin on servers running the latest published version of its places.
+//
+// Returns an error if the universe ID is empty or the HTTP request fails.
+func (u *Universe) RestartServers() error {
+	return u.RestartServersContext(context.Background())
+}
+
+// RestartServersContext is like RestartServers but uses the provided context for the request.
+func (u *Universe) RestartServersContext(ctx context.Context) error {
+	if u.ID == "" {
+		return ErrNoUniverseID
+	}
+
+	resp, err := u.Client.post(ctx, u.Client.cloudEndpoint(u.cloudPath(":restartServers")), map[string]interface{}{}, nil, nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// ListVersions returns a Pager over the versions of the place, newest first.
+// Pages are only fetched as the caller iterates.
+func (p *Place) ListVersions(opts PageOptions) *Pager[PlaceVersion] {
+	return newPager[PlaceVersion](p.Universe.Client, p.Universe.Client.cloudEndpoint(p.cloudPath("/versions")), "placeVersions", nil, opts)
+}
@@ -0,0 +1,47 @@
+package robloxgo
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestUniverse_RestartServers(t *testing.T) {
+	calls := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.Method != http.MethodPost || r.URL.Path != "/cloud/v2/universes/9:restartServers" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusForbidden)
+		io.WriteString(w, `{"code":"PERMISSION_DENIED","message":"missing scope"}`)
+	})
+
+	err := client.Universe("9").RestartServers()
+	if !errors.Is(err, ErrPermissionDenied) || calls != 1 {
+		t.Fatalf("expected a single ErrPermissionDenied, got %v after %d calls", err, calls)
+	}
+}
+
+func TestPlace_ListVersions(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/cloud/v2/universes/9/places/3/versions" {
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+		if r.URL.Query().Get("pageToken") == "" {
+			io.WriteString(w, `{"placeVersions":[{"path":"universes/9/places/3/versions/12","createTime":"2025-01-02T00:00:00Z"}],"nextPageToken":"next"}`)
+			return
+		}
+		io.WriteString(w, `{"placeVersions":[{"path":"universes/9/places/3/versions/11"}]}`)
+	})
+
+	versions, err := client.Universe("9").Place("3").ListVersions(PageOptions{}).All(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(versions) != 2 || versions[0].VersionNumber != 12 || versions[0].CreatedAt.IsZero() || versions[1].VersionNumber != 11 {
+		t.Fatalf("unexpected versions: %+v", versions)
+	}
+}
@@ -0,0 +1,258 @@
+package robloxgo
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimitBucket identifies a family of Roblox APIs that share a rate limit budget.
+type RateLimitBucket string
+
+// Rate limit buckets used by the client. Requests are sorted into a bucket by their URL.
+const (
+	BucketCloudUsers   RateLimitBucket = "cloud-users"
+	BucketCloudGroups  RateLimitBucket = "cloud-groups"
+	BucketLegacyUsers  RateLimitBucket = "legacy-users"
+	BucketLegacyGroups RateLimitBucket = "legacy-groups"
+	BucketThumbnails   RateLimitBucket = "thumbnails"
+	BucketDataStores   RateLimitBucket = "data-stores"
+	BucketMemoryStores RateLimitBucket = "memory-stores"
+	BucketMessaging    RateLimitBucket = "messaging"
+	BucketUniverses    RateLimitBucket = "universes"
+	BucketOther        RateLimitBucket = "other"
+)
+
+// RateLimit describes the budget of a single bucket.
+type RateLimit struct {
+	// Requests is the number of requests allowed every Per.
+	Requests int
+
+	// Per is the window in which Requests may be made.
+	Per time.Duration
+
+	// Burst is the maximum number of requests that may be made at once.
+	// A value of 0 defaults to 1.
+	Burst int
+}
+
+// RateLimitState is a snapshot of a bucket, intended for monitoring.
+type RateLimitState struct {
+	// Limit is the configured budget of the bucket.
+	Limit RateLimit
+
+	// Tokens is the number of requests that can currently be made without waiting.
+	// It is negative when requests are queued waiting for the bucket to refill.
+	Tokens float64
+
+	// Waiting is the number of requests currently waiting on the bucket.
+	Waiting int
+
+	// PausedUntil is set when the API reported the rate limit as exhausted
+	// through the x-ratelimit-* headers, and no requests are sent before then.
+	PausedUntil time.Time
+}
+
+// DefaultRateLimits are the budgets used by clients created without WithRateLimits or WithRateLimiter.
+//
+// They are kept below the documented Open Cloud limit of 300 requests per minute per API key.
+var DefaultRateLimits = map[RateLimitBucket]RateLimit{
+	BucketCloudUsers:   {Requests: 300, Per: time.Minute, Burst: 10},
+	BucketCloudGroups:  {Requests: 300, Per: time.Minute, Burst: 10},
+	BucketLegacyUsers:  {Requests: 60, Per: time.Minute, Burst: 5},
+	BucketLegacyGroups: {Requests: 60, Per: time.Minute, Burst: 5},
+	BucketThumbnails:   {Requests: 300, Per: time.Minute, Burst: 10},
+	BucketDataStores:   {Requests: 600, Per: time.Minute, Burst: 20},
+	BucketMemoryStores: {Requests: 600, Per: time.Minute, Burst: 20},
+	BucketMessaging:    {Requests: 150, Per: time.Minute, Burst: 10},
+	BucketUniverses:    {Requests: 300, Per: time.Minute, Burst: 10},
+	BucketOther:        {Requests: 300, Per: time.Minute, Burst: 10},
+}
+
+// RateLimiter is a client side token bucket rate limiter with a separate bucket per API family.
+//
+// Every request made by a Client waits on the limiter before it is sent, so concurrent
+// callers share the same budget. A RateLimiter can be shared between several clients
+// using the same API key through WithRateLimiter.
+type RateLimiter struct {
+	mu      sync.Mutex
+	buckets map[RateLimitBucket]*tokenBucket
+}
+
+type tokenBucket struct {
+	limit       RateLimit
+	tokens      float64
+	last        time.Time
+	waiting     int
+	pausedUntil time.Time
+}
+
+// NewRateLimiter creates a RateLimiter with the provided limits.
+//
+// Buckets missing from limits fall back to DefaultRateLimits.
+func NewRateLimiter(limits map[RateLimitBucket]RateLimit) *RateLimiter {
+	l := &RateLimiter{buckets: make(map[RateLimitBucket]*tokenBucket)}
+	for bucket, limit := range DefaultRateLimits {
+		l.buckets[bucket] = newTokenBucket(limit)
+	}
+	for bucket, limit := range limits {
+		l.buckets[bucket] = newTokenBucket(limit)
+	}
+
+	return l
+}
+
+func newTokenBucket(limit RateLimit) *tokenBucket {
+	if limit.Burst <= 0 {
+		limit.Burst = 1
+	}
+
+	return &tokenBucket{
+		limit:  limit,
+		tokens: float64(limit.Burst),
+		last:   time.Now(),
+	}
+}
+
+// rate returns the number of tokens the bucket regains per second.
+func (b *tokenBucket) rate() float64 {
+	if b.limit.Requests <= 0 || b.limit.Per <= 0 {
+		return 0
+	}
+
+	return float64(b.limit.Requests) / b.limit.Per.Seconds()
+}
+
+// refill adds the tokens earned since the last refill, up to the burst size.
+func (b *tokenBucket) refill(now time.Time) {
+	b.tokens += now.Sub(b.last).Seconds() * b.rate()
+	if b.tokens > float64(b.limit.Burst) {
+		b.tokens = float64(b.limit.Burst)
+	}
+	b.last = now
+}
+
+// Wait blocks until a request may be made in the given bucket, or until the context is done.
+//
+// Buckets without a configured limit never block.
+func (l *RateLimiter) Wait(ctx context.Context, bucket RateLimitBucket) error {
+	l.mu.Lock()
+	b, ok := l.buckets[bucket]
+	if !ok || b.rate() == 0 {
+		l.mu.Unlock()
+		return ctx.Err()
+	}
+
+	now := time.Now()
+	b.refill(now)
+	b.tokens--
+
+	var wait time.Duration
+	if b.tokens < 0 {
+		wait = time.Duration(-b.tokens / b.rate() * float64(time.Second))
+	}
+	if paused := b.pausedUntil.Sub(now); paused > wait {
+		wait = paused
+	}
+	if wait <= 0 {
+		l.mu.Unlock()
+		return nil
+	}
+	b.waiting++
+	l.mu.Unlock()
+
+	err := sleepContext(ctx, wait)
+
+	l.mu.Lock()
+	b.waiting--
+	if err != nil {
+		// Hand the reserved token back so cancelled callers do not slow down the others.
+		b.tokens++
+	}
+	l.mu.Unlock()
+
+	return err
+}
+
+// State returns a snapshot of every bucket in the limiter.
+func (l *RateLimiter) State() map[RateLimitBucket]RateLimitState {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	state := make(map[RateLimitBucket]RateLimitState, len(l.buckets))
+	for bucket, b := range l.buckets {
+		b.refill(now)
+		state[bucket] = RateLimitState{
+			Limit:       b.limit,
+			Tokens:      b.tokens,
+			Waiting:     b.waiting,
+			PausedUntil: b.pausedUntil,
+		}
+	}
+
+	return state
+}
+
+// observe reads the x-ratelimit-remaining and x-ratelimit-reset headers of a response
+// and pauses the bucket until the reset when the API reports the budget as exhausted.
+func (l *RateLimiter) observe(bucket RateLimitBucket, resp *http.Response) {
+	if resp.Header.Get("x-ratelimit-remaining") != "0" {
+		return
+	}
+	reset, err := strconv.ParseFloat(strings.TrimSpace(resp.Header.Get("x-ratelimit-reset")), 64)
+	if err != nil || reset <= 0 {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if b, ok := l.buckets[bucket]; ok {
+		until := time.Now().Add(time.Duration(reset * float64(time.Second)))
+		if until.After(b.pausedUntil) {
+			b.pausedUntil = until
+		}
+	}
+}
+
+// bucketFor returns the rate limit bucket that a request to methodURL belongs to,
+// based on the client's base URLs.
+func (c *Client) bucketFor(methodURL string) RateLimitBucket {
+	switch {
+	case strings.HasPrefix(methodURL, c.cloudEndpoint("users/")):
+		return BucketCloudUsers
+	case strings.HasPrefix(methodURL, c.cloudEndpoint("groups/")):
+		return BucketCloudGroups
+	case strings.HasPrefix(methodURL, c.cloudEndpoint("universes/")) && strings.Contains(methodURL, "data-stores/"):
+		return BucketDataStores
+	case strings.HasPrefix(methodURL, c.cloudEndpoint("universes/")) && strings.Contains(methodURL, "/memory-store/"):
+		return BucketMemoryStores
+	case strings.HasPrefix(methodURL, c.cloudEndpoint("universes/")) && strings.HasSuffix(methodURL, ":publishMessage"):
+		return BucketMessaging
+	case strings.HasPrefix(methodURL, c.cloudEndpoint("universes/")),
+		strings.HasPrefix(methodURL, c.endpoint(FamilyCloud, "/universes/")):
+		return BucketUniverses
+	case strings.HasPrefix(methodURL, c.baseURLs[FamilyUsers]):
+		return BucketLegacyUsers
+	case strings.HasPrefix(methodURL, c.baseURLs[FamilyGroups]):
+		return BucketLegacyGroups
+	case strings.HasPrefix(methodURL, c.baseURLs[FamilyThumbnails]):
+		return BucketThumbnails
+	}
+
+	return BucketOther
+}
+
+// RateLimitState returns a snapshot of the client's rate limiter buckets, intended for monitoring.
+//
+// Returns nil if rate limiting has been disabled with WithRateLimiter(nil).
+func (c *Client) RateLimitState() map[RateLimitBucket]RateLimitState {
+	if c.limiter == nil {
+		return nil
+	}
+
+	return c.limiter.State()
+}
@@ -0,0 +1,82 @@
+package robloxgo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter_WaitsWhenBucketEmpty(t *testing.T) {
+	limiter := NewRateLimiter(map[RateLimitBucket]RateLimit{
+		BucketCloudGroups: {Requests: 20, Per: time.Second, Burst: 1},
+	})
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := limiter.Wait(ctx, BucketCloudGroups); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 80*time.Millisecond {
+		t.Fatalf("expected limiter to throttle, took %v", elapsed)
+	}
+}
+
+func TestRateLimiter_BucketsAreIndependent(t *testing.T) {
+	limiter := NewRateLimiter(map[RateLimitBucket]RateLimit{
+		BucketCloudGroups: {Requests: 1, Per: time.Hour, Burst: 1},
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if err := limiter.Wait(ctx, BucketCloudGroups); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := limiter.Wait(ctx, BucketCloudUsers); err != nil {
+		t.Fatalf("unexpected error waiting on another bucket: %v", err)
+	}
+	if err := limiter.Wait(ctx, BucketCloudGroups); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+
+	state := limiter.State()[BucketCloudGroups]
+	if state.Waiting != 0 || state.Tokens >= 1 {
+		t.Fatalf("unexpected bucket state: %+v", state)
+	}
+}
+
+func TestRateLimiter_ObservePausesBucket(t *testing.T) {
+	limiter := NewRateLimiter(nil)
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("x-ratelimit-remaining", "0")
+	resp.Header.Set("x-ratelimit-reset", "30")
+
+	limiter.observe(BucketCloudUsers, resp)
+
+	state := limiter.State()[BucketCloudUsers]
+	if time.Until(state.PausedUntil) < 25*time.Second {
+		t.Fatalf("expected bucket to be paused, got %v", state.PausedUntil)
+	}
+}
+
+func TestBucketFor(t *testing.T) {
+	client, _ := Create("key")
+	cases := map[string]RateLimitBucket{
+		EndPointCloudUsers + "1":          BucketCloudUsers,
+		EndpointCloudGroups + "7/roles":   BucketCloudGroups,
+		EndpointLegacyGetUsers:            BucketLegacyUsers,
+		EndpointLegacyGetGroups:           BucketLegacyGroups,
+		EndpointLegacyGetGroupIcon:        BucketThumbnails,
+		"https://example.com/other/thing": BucketOther,
+		EndpointApis + "/cloud/v2/universes/1/memory-store/sorted-maps/m/items": BucketMemoryStores,
+		EndpointApis + "/universes/v1/1/places/2/versions":                      BucketUniverses,
+	}
+	for methodURL, expected := range cases {
+		if bucket := client.bucketFor(methodURL); bucket != expected {
+			t.Errorf("bucketFor(%q) = %q, expected %q", methodURL, bucket, expected)
+		}
+	}
+}
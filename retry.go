@@ -0,0 +1,100 @@
+package robloxgo
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RetryPolicy controls how failed requests are retried by a Client.
+//
+// Every request made by the client goes through the policy. Between attempts the client
+// waits for an exponentially increasing backoff, unless the API provided a Retry-After
+// or x-ratelimit-reset header, in which case that delay is honored instead.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts made for a request, including the first.
+	// A value of 1 or less disables retries.
+	MaxAttempts int
+
+	// BaseBackoff is the delay before the second attempt. It doubles for every attempt after that.
+	BaseBackoff time.Duration
+
+	// MaxBackoff caps the exponential backoff between attempts.
+	MaxBackoff time.Duration
+
+	// Jitter is the fraction (0 to 1) by which each backoff is randomly shortened or lengthened.
+	Jitter float64
+
+	// RetryableStatusCodes lists the HTTP status codes that are retried.
+	RetryableStatusCodes []int
+}
+
+// DefaultRetryPolicy is the RetryPolicy used by clients created without WithRetryPolicy.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 4,
+	BaseBackoff: 500 * time.Millisecond,
+	MaxBackoff:  30 * time.Second,
+	Jitter:      0.2,
+	RetryableStatusCodes: []int{
+		http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout,
+	},
+}
+
+// NoRetryPolicy disables retries entirely.
+var NoRetryPolicy = RetryPolicy{MaxAttempts: 1}
+
+// shouldRetry reports whether a response with the given status code should be retried.
+//
+// Requests that are not idempotent are only retried on 429 Too Many Requests,
+// since the API guarantees a rate limited request was not processed.
+func (p RetryPolicy) shouldRetry(statusCode int, idempotent bool) bool {
+	if !idempotent && statusCode != http.StatusTooManyRequests {
+		return false
+	}
+	for _, code := range p.RetryableStatusCodes {
+		if code == statusCode {
+			return true
+		}
+	}
+
+	return false
+}
+
+// retryDelay returns how long to wait before the next attempt after a failed response.
+//
+// A Retry-After header takes priority, followed by x-ratelimit-reset when the rate limit
+// has been exhausted. Otherwise the exponential backoff for the attempt is used.
+func (p RetryPolicy) retryDelay(attempt int, resp *http.Response) time.Duration {
+	if wait := parseRetryAfter(resp.Header.Get("Retry-After")); wait > 0 {
+		return wait
+	}
+	if resp.StatusCode == http.StatusTooManyRequests || resp.Header.Get("x-ratelimit-remaining") == "0" {
+		if reset, err := strconv.ParseFloat(strings.TrimSpace(resp.Header.Get("x-ratelimit-reset")), 64); err == nil && reset > 0 {
+			return time.Duration(reset * float64(time.Second))
+		}
+	}
+
+	return p.backoff(attempt)
+}
+
+// backoff returns the jittered exponential backoff after the given attempt.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	wait := p.BaseBackoff
+	for i := 1; i < attempt && (p.MaxBackoff <= 0 || wait < p.MaxBackoff); i++ {
+		wait *= 2
+	}
+	if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+	if p.Jitter > 0 {
+		wait += time.Duration(p.Jitter * (rand.Float64()*2 - 1) * float64(wait))
+	}
+
+	return wait
+}
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"net/http"
 	"runtime"
+	"time"
 )
 
 // Version of RobloxGo. Follows Semantic Versioning. (https://semver.org)
@@ -21,22 +22,49 @@ var robloxGoUserAgent = fmt.Sprintf("RobloxGo v%s (+https://github.com/RhykerWel
 // Create initialises and returns a new Roblox client with the provided API key.
 // The client automatically attaches the API key to all outgoing requests via the "X-API-KEY" header
 //
+// Options can be passed to customise the client, such as WithHTTPClient, WithBaseURL,
+// WithRetryPolicy, WithRateLimits or WithCache.
+//
 // Returns an error if the API key is empty
-func Create(apikey string) (*Client, error) {
+func Create(apikey string, opts ...Option) (*Client, error) {
 	if apikey == "" {
 		return nil, ErrNoAPIKey
 	}
 
-	httpClient := &http.Client{
-		Transport: &APIVerificationStruct{
-			APIKey:    apikey,
-			Transport: http.DefaultTransport,
-		},
+	client := &Client{
+		baseURLs:    defaultBaseURLs(),
+		userAgent:   robloxGoUserAgent,
+		retryPolicy: DefaultRetryPolicy,
+		limiter:     NewRateLimiter(nil),
+		cache:       NewMemoryCache(DefaultCacheSize),
+		cacheTTLs:   make(map[CacheResource]time.Duration, len(DefaultCacheTTLs)),
+	}
+	for resource, ttl := range DefaultCacheTTLs {
+		client.cacheTTLs[resource] = ttl
+	}
+	for _, opt := range opts {
+		opt(client)
 	}
 
-	client := &Client{
-		client: httpClient,
+	httpClient := &http.Client{}
+	if client.client != nil {
+		*httpClient = *client.client
+	}
+	transport := httpClient.Transport
+	if client.transport != nil {
+		transport = client.transport
+	}
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	httpClient.Transport = &APIVerificationStruct{
+		APIKey:    apikey,
+		Transport: transport,
 	}
+	if client.timeout > 0 {
+		httpClient.Timeout = client.timeout
+	}
+	client.client = httpClient
 
 	return client, nil
 }
@@ -45,6 +73,29 @@ func Create(apikey string) (*Client, error) {
 // all help functions to be accessed from
 type Client struct {
 	client *http.Client
+
+	// transport and timeout are set by options and applied to client by Create
+	transport http.RoundTripper
+	timeout   time.Duration
+
+	// baseURLs holds the base URL of each API family
+	baseURLs map[APIFamily]string
+
+	// userAgent is sent as the User-Agent header of every request
+	userAgent string
+
+	// logger reports retried requests, or nil if logging is disabled
+	logger Logger
+
+	// retryPolicy controls how failed requests are retried
+	retryPolicy RetryPolicy
+
+	// limiter throttles outgoing requests, or nil if rate limiting is disabled
+	limiter *RateLimiter
+
+	// cache stores rarely changing resources, or nil if caching is disabled
+	cache     Cache
+	cacheTTLs map[CacheResource]time.Duration
 }
 
 type APIVerificationStruct struct {
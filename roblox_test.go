@@ -1,8 +1,12 @@
 package robloxgo
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
+	"time"
 )
 
 // Test Create returns error on empty API key
@@ -28,3 +32,65 @@ func TestCreate_PopulatedAPIKey(t *testing.T) {
 		t.Fatal("expected client, got nil")
 	}
 }
+
+// newTestClient returns a client with every API family pointed at a local server using the provided handler.
+func newTestClient(t *testing.T, handler http.HandlerFunc, opts ...Option) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	opts = append([]Option{
+		WithBaseURL(FamilyCloud, server.URL),
+		WithBaseURL(FamilyUsers, server.URL),
+		WithBaseURL(FamilyGroups, server.URL),
+		WithBaseURL(FamilyThumbnails, server.URL),
+		WithRetryPolicy(testRetryPolicy),
+		WithRateLimiter(nil),
+	}, opts...)
+	client, err := Create("test-key", opts...)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return client
+}
+
+// Test Create applies base URL, user agent and transport options
+func TestCreate_Options(t *testing.T) {
+	var transportUsed bool
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		transportUsed = true
+		return http.DefaultTransport.RoundTrip(req)
+	})
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/cloud/v2/users/1" {
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+		if r.Header.Get("X-API-KEY") != "test-key" {
+			t.Errorf("expected api key header, got %q", r.Header.Get("X-API-KEY"))
+		}
+		if !strings.HasSuffix(r.Header.Get("User-Agent"), " my-bot/1.0") {
+			t.Errorf("expected user agent suffix, got %q", r.Header.Get("User-Agent"))
+		}
+		w.Write([]byte(`{"id":"1","name":"Roblox"}`))
+	}, WithUserAgentSuffix("my-bot/1.0"), WithTransport(transport), WithTimeout(time.Second))
+
+	user, err := client.GetUserByID("1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if user.Username != "Roblox" {
+		t.Fatalf("expected username Roblox, got %q", user.Username)
+	}
+	if !transportUsed {
+		t.Fatal("expected custom transport to be used")
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,265 @@
+package robloxgo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Universe represents a Roblox experience (universe), and serves as the base
+// for the experience scoped Open Cloud APIs such as data stores.
+//
+// Handles returned by Client.Universe only have their ID set;
+// the remaining fields are populated by GetUniverse and Update.
+type Universe struct {
+	// ID is the unique identifier of the universe.
+	ID string
+
+	// Path is the Open Cloud resource path of the universe.
+	Path string `json:"path"`
+
+	// OwnerUserID is the user ID of the universe owner, if it is owned by a user.
+	OwnerUserID string `json:"user"`
+
+	// OwnerGroupID is the group ID of the universe owner, if it is owned by a group.
+	OwnerGroupID string `json:"group"`
+
+	// Visibility is whether the universe is PUBLIC or PRIVATE.
+	Visibility string `json:"visibility"`
+
+	// AgeRating is the content maturity rating of the universe.
+	AgeRating string `json:"ageRating"`
+
+	// CreatedAt is the timestamp of when the universe was created.
+	CreatedAt time.Time `json:"createTime"`
+
+	// UpdatedAt is the timestamp of when the universe was last updated.
+	UpdatedAt time.Time `json:"updateTime"`
+
+	// UniverseSettings holds the settings of the universe that can be changed with Update.
+	UniverseSettings
+
+	// Client is the API client used to interact with the universe.
+	Client *Client
+}
+
+// UniverseSettings holds the settings of a universe that can be changed with Universe.Update.
+type UniverseSettings struct {
+	// DisplayName is the name of the universe.
+	DisplayName string `json:"displayName"`
+
+	// Description is the public description of the universe.
+	Description string `json:"description"`
+
+	// VoiceChatEnabled reports whether voice chat is enabled in the universe.
+	VoiceChatEnabled bool `json:"voiceChatEnabled"`
+
+	// PrivateServerPriceRobux is the price of private servers in Robux,
+	// or nil if private servers are disabled.
+	PrivateServerPriceRobux *int64 `json:"privateServerPriceRobux"`
+
+	// DesktopEnabled, MobileEnabled, TabletEnabled, ConsoleEnabled and VREnabled
+	// report which devices the universe can be played on.
+	DesktopEnabled bool `json:"desktopEnabled"`
+	MobileEnabled  bool `json:"mobileEnabled"`
+	TabletEnabled  bool `json:"tabletEnabled"`
+	ConsoleEnabled bool `json:"consoleEnabled"`
+	VREnabled      bool `json:"vrEnabled"`
+
+	// The social links shown on the universe's page, or nil if they are not set.
+	FacebookSocialLink    *SocialLink `json:"facebookSocialLink"`
+	TwitterSocialLink     *SocialLink `json:"twitterSocialLink"`
+	YoutubeSocialLink     *SocialLink `json:"youtubeSocialLink"`
+	TwitchSocialLink      *SocialLink `json:"twitchSocialLink"`
+	DiscordSocialLink     *SocialLink `json:"discordSocialLink"`
+	RobloxGroupSocialLink *SocialLink `json:"robloxGroupSocialLink"`
+	GuildedSocialLink     *SocialLink `json:"guildedSocialLink"`
+}
+
+// SocialLink is a link shown on a universe's page.
+type SocialLink struct {
+	// Title is the text shown for the link.
+	Title string `json:"title"`
+
+	// URI is the address the link points to.
+	URI string `json:"uri"`
+}
+
+// PlaceVersionType is the kind of place version created by PublishPlace.
+type PlaceVersionType string
+
+// Place version types accepted by PublishPlace.
+const (
+	// VersionTypeSaved saves the place file without making it live.
+	VersionTypeSaved PlaceVersionType = "Saved"
+
+	// VersionTypePublished saves the place file and makes it the live version.
+	VersionTypePublished PlaceVersionType = "Published"
+)
+
+// Universe returns a handle to the universe with the provided ID.
+//
+// No request is made; the handle is used to access the universe's resources,
+// such as its data stores. Use GetUniverse to also fetch the universe's settings.
+func (c *Client) Universe(universeID string) *Universe {
+	return &Universe{
+		ID:     universeID,
+		Client: c,
+	}
+}
+
+// cloudPath returns the Open Cloud resource path of the universe, followed by the provided suffix.
+func (u *Universe) cloudPath(suffix string) string {
+	return "universes/" + u.ID + suffix
+}
+
+// GetUniverse retrieves a Roblox universe and its settings from the Open Cloud API.
+//
+// It returns a Universe instance associated with the current Client.
+// An error is returned if the universe ID is empty, if the HTTP request fails,
+// if the response cannot be decoded, or if the universe does not exist.
+func (c *Client) GetUniverse(universeID string) (*Universe, error) {
+	return c.GetUniverseContext(context.Background(), universeID)
+}
+
+// GetUniverseContext is like GetUniverse but uses the provided context for the request.
+func (c *Client) GetUniverseContext(ctx context.Context, universeID string) (*Universe, error) {
+	if universeID == "" {
+		return nil, ErrNoUniverseID
+	}
+
+	universe := c.Universe(universeID)
+	resp, err := c.get(ctx, c.cloudEndpoint(universe.cloudPath("")), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	err = universe.decode(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return universe, nil
+}
+
+// decode reads an Open Cloud universe from r into u, keeping the handle's ID and Client.
+func (u *Universe) decode(r io.Reader) error {
+	err := json.NewDecoder(r).Decode(u)
+	if err != nil {
+		return err
+	}
+	u.OwnerUserID = strings.TrimPrefix(u.OwnerUserID, "users/")
+	u.OwnerGroupID = strings.TrimPrefix(u.OwnerGroupID, "groups/")
+
+	return nil
+}
+
+// Update changes the settings of the universe named in mask to their values in settings,
+// leaving every other setting untouched. The mask holds the JSON field names of
+// UniverseSettings, such as "displayName", "voiceChatEnabled" or "discordSocialLink".
+//
+// It returns the updated universe.
+// Returns an error wrapping ErrInvalidUpdateMask if the mask is empty or names an unknown
+// setting, or an error if the HTTP request fails or the response cannot be decoded.
+func (u *Universe) Update(mask []string, settings UniverseSettings) (*Universe, error) {
+	return u.UpdateContext(context.Background(), mask, settings)
+}
+
+// UpdateContext is like Update but uses the provided context for the request.
+func (u *Universe) UpdateContext(ctx context.Context, mask []string, settings UniverseSettings) (*Universe, error) {
+	if u.ID == "" {
+		return nil, ErrNoUniverseID
+	}
+	if len(mask) == 0 {
+		return nil, ErrInvalidUpdateMask
+	}
+
+	encoded, err := json.Marshal(settings)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	err = json.Unmarshal(encoded, &fields)
+	if err != nil {
+		return nil, err
+	}
+
+	requestBody := make(map[string]json.RawMessage, len(mask))
+	for _, path := range mask {
+		field := strings.SplitN(path, ".", 2)[0]
+		value, ok := fields[field]
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidUpdateMask, path)
+		}
+		requestBody[field] = value
+	}
+
+	query := []queryParam{{Key: "updateMask", Value: strings.Join(mask, ",")}}
+	resp, err := u.Client.patch(ctx, u.Client.cloudEndpoint(u.cloudPath("")), requestBody, nil, query)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	universe := u.Client.Universe(u.ID)
+	err = universe.decode(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return universe, nil
+}
+
+// PublishPlace uploads a place file to a place of the universe, creating a new place version.
+//
+// Both binary (.rbxl) and XML (.rbxlx) place files are accepted; the format is detected from
+// the file's contents. The file is read fully into memory before it is sent.
+// It returns the number of the new place version.
+// Returns an error if the universe or place ID is empty, the file cannot be read,
+// the HTTP request fails, or the response cannot be decoded.
+func (u *Universe) PublishPlace(placeID string, placeFile io.Reader, versionType PlaceVersionType) (int64, error) {
+	return u.PublishPlaceContext(context.Background(), placeID, placeFile, versionType)
+}
+
+// PublishPlaceContext is like PublishPlace but uses the provided context for the request.
+func (u *Universe) PublishPlaceContext(ctx context.Context, placeID string, placeFile io.Reader, versionType PlaceVersionType) (int64, error) {
+	if u.ID == "" {
+		return 0, ErrNoUniverseID
+	}
+	if placeID == "" {
+		return 0, ErrNoPlaceID
+	}
+
+	data, err := io.ReadAll(placeFile)
+	if err != nil {
+		return 0, err
+	}
+	body := rawBody{data: data, contentType: "application/xml"}
+	if bytes.HasPrefix(data, []byte("<roblox!")) {
+		body.contentType = "application/octet-stream"
+	}
+
+	methodURL := u.Client.endpoint(FamilyCloud, "/universes/v1/"+u.ID+"/places/"+placeID+"/versions")
+	query := []queryParam{{Key: "versionType", Value: string(versionType)}}
+	resp, err := u.Client.post(ctx, methodURL, body, nil, query)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	var Response struct {
+		VersionNumber json.Number `json:"versionNumber"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&Response)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseInt(Response.VersionNumber.String(), 10, 64)
+}
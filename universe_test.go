@@ -0,0 +1,86 @@
+package robloxgo
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestGetUniverse(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/cloud/v2/universes/9" {
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+		io.WriteString(w, `{"path":"universes/9","displayName":"Obby","group":"groups/7","voiceChatEnabled":true,
+			"privateServerPriceRobux":25,"discordSocialLink":{"title":"Discord","uri":"https://discord.gg/x"}}`)
+	})
+
+	universe, err := client.GetUniverse("9")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if universe.ID != "9" || universe.Client != client || universe.OwnerGroupID != "7" || universe.DisplayName != "Obby" {
+		t.Fatalf("unexpected universe: %+v", universe)
+	}
+	if !universe.VoiceChatEnabled || *universe.PrivateServerPriceRobux != 25 || universe.DiscordSocialLink.URI != "https://discord.gg/x" {
+		t.Fatalf("unexpected settings: %+v", universe.UniverseSettings)
+	}
+}
+
+func TestUniverse_UpdateSendsMaskedFields(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Query().Get("updateMask") != "description,twitterSocialLink.uri" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if len(body) != 2 || body["description"] != "new" || body["twitterSocialLink"].(map[string]interface{})["uri"] != "https://x.com/obby" {
+			t.Errorf("unexpected body %v", body)
+		}
+		io.WriteString(w, `{"path":"universes/9","description":"new"}`)
+	})
+
+	settings := UniverseSettings{
+		DisplayName:       "ignored",
+		Description:       "new",
+		TwitterSocialLink: &SocialLink{URI: "https://x.com/obby"},
+	}
+	universe, err := client.Universe("9").Update([]string{"description", "twitterSocialLink.uri"}, settings)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if universe.Description != "new" || universe.ID != "9" {
+		t.Fatalf("unexpected universe: %+v", universe)
+	}
+
+	if _, err := client.Universe("9").Update([]string{"unknown"}, settings); !errors.Is(err, ErrInvalidUpdateMask) {
+		t.Fatalf("expected ErrInvalidUpdateMask, got %v", err)
+	}
+}
+
+func TestUniverse_PublishPlace(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/universes/v1/9/places/3/versions" || r.URL.Query().Get("versionType") != "Published" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		if contentType := r.Header.Get("Content-Type"); contentType != "application/octet-stream" {
+			t.Errorf("unexpected content type %q", contentType)
+		}
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != "<roblox!binary" {
+			t.Errorf("unexpected body %q", body)
+		}
+		io.WriteString(w, `{"versionNumber":12}`)
+	})
+
+	version, err := client.Universe("9").PublishPlace("3", strings.NewReader("<roblox!binary"), VersionTypePublished)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if version != 12 {
+		t.Fatalf("unexpected version %d", version)
+	}
+}
@@ -1,7 +1,18 @@
 package robloxgo
 
 import (
+	"context"
 	"encoding/json"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Limits of the bulk user lookups: the number of users per request and
+// the number of requests in flight at once.
+const (
+	userBatchSize        = 100
+	userBatchConcurrency = 4
 )
 
 // User represents a Roblox user and its associated metadata.
@@ -44,11 +55,25 @@ func newUser(client *Client) *User {
 // An error is returned if the user ID is empty, if the HTTP request fails,
 // if the response cannot be decoded, or if the user does not exist.
 func (c *Client) GetUserByID(userID string) (*User, error) {
+	return c.GetUserByIDContext(context.Background(), userID)
+}
+
+// GetUserByIDContext is like GetUserByID but uses the provided context for the request.
+//
+// Users are served from the client's cache when possible. Pass a context
+// created with WithoutCache to always fetch the user from the API.
+func (c *Client) GetUserByIDContext(ctx context.Context, userID string) (*User, error) {
 	if userID == "" {
 		return nil, ErrNoUserID
 	}
 
-	resp, err := c.get(EndPointCloudUsers+userID, nil, nil)
+	key := cacheKey(CacheUsers, userID)
+	if cached, ok := c.cacheGet(ctx, CacheUsers, key); ok {
+		user := cached.(User)
+		return &user, nil
+	}
+
+	resp, err := c.get(ctx, c.cloudEndpoint("users/"+userID), nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -59,6 +84,7 @@ func (c *Client) GetUserByID(userID string) (*User, error) {
 	if err != nil {
 		return nil, err
 	}
+	c.cacheSet(CacheUsers, key, *user)
 
 	return user, nil
 }
@@ -72,12 +98,17 @@ func (c *Client) GetUserByID(userID string) (*User, error) {
 // Note: This method depends on the legacy endpoint at https://users.roblox.com/v1/usernames/users,
 // which may be deprecated or removed by Roblox in the future.
 func (c *Client) GetUserByUsername(username string) (*User, error) {
+	return c.GetUserByUsernameContext(context.Background(), username)
+}
+
+// GetUserByUsernameContext is like GetUserByUsername but uses the provided context for both requests.
+func (c *Client) GetUserByUsernameContext(ctx context.Context, username string) (*User, error) {
 	if username == "" {
 		return nil, ErrNoUsername
 	}
 
 	requestBody := map[string]interface{}{"usernames": []string{username}, "excludeBannedUsers": true}
-	resp, err := c.post(EndpointLegacyGetUsers, requestBody, nil, nil)
+	resp, err := c.postIdempotent(ctx, c.endpoint(FamilyUsers, pathLegacyGetUsers), requestBody, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -96,7 +127,7 @@ func (c *Client) GetUserByUsername(username string) (*User, error) {
 	}
 
 	legacyUser := &Response.Data[0]
-	resp, err = c.get(EndPointCloudUsers+legacyUser.ID.String(), nil, nil)
+	resp, err = c.get(ctx, c.cloudEndpoint("users/"+legacyUser.ID.String()), nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -118,8 +149,13 @@ func (c *Client) GetUserByUsername(username string) (*User, error) {
 //
 // Returns an error if the HTTP request fails or if the response body cannot be decoded.
 func (u *User) GetUserThumbnailURI(queryParams []queryParam) (string, error) {
-	methodURL := EndPointCloudUsers + u.ID.String() + ":generateThumbnail"
-	resp, err := u.Client.get(methodURL, nil, queryParams)
+	return u.GetUserThumbnailURIContext(context.Background(), queryParams)
+}
+
+// GetUserThumbnailURIContext is like GetUserThumbnailURI but uses the provided context for the request.
+func (u *User) GetUserThumbnailURIContext(ctx context.Context, queryParams []queryParam) (string, error) {
+	methodURL := u.Client.cloudEndpoint("users/" + u.ID.String() + ":generateThumbnail")
+	resp, err := u.Client.get(ctx, methodURL, nil, queryParams)
 	if err != nil {
 		return "", err
 	}
@@ -138,3 +174,147 @@ func (u *User) GetUserThumbnailURI(queryParams []queryParam) (string, error) {
 
 	return thumbnailResponse.Response.ImageURI, nil
 }
+
+// GetUsersByIDs retrieves Roblox users in bulk by their user IDs using the legacy Roblox API.
+//
+// The IDs are split into chunks of up to 100, which are requested concurrently.
+// It returns the users keyed by user ID, along with the IDs that are invalid or do not exist.
+// Only the ID, Username and Displayname fields of the returned users are populated;
+// use GetUserByID for the full Open Cloud profile.
+//
+// An error is returned if any request fails or a response cannot be decoded.
+func (c *Client) GetUsersByIDs(userIDs []string) (map[string]*User, []string, error) {
+	return c.GetUsersByIDsContext(context.Background(), userIDs)
+}
+
+// GetUsersByIDsContext is like GetUsersByIDs but uses the provided context for every request.
+func (c *Client) GetUsersByIDsContext(ctx context.Context, userIDs []string) (map[string]*User, []string, error) {
+	var ids []int64
+	var notFound []string
+	seen := make(map[string]bool, len(userIDs))
+	for _, userID := range userIDs {
+		if seen[userID] {
+			continue
+		}
+		seen[userID] = true
+
+		id, err := strconv.ParseInt(userID, 10, 64)
+		if err != nil {
+			notFound = append(notFound, userID)
+			continue
+		}
+		ids = append(ids, id)
+	}
+
+	users := make(map[string]*User, len(ids))
+	var mu sync.Mutex
+	err := forEachChunk(ctx, len(ids), userBatchSize, userBatchConcurrency, func(ctx context.Context, start, end int) error {
+		requestBody := map[string]interface{}{"userIds": ids[start:end], "excludeBannedUsers": false}
+		resp, err := c.postIdempotent(ctx, c.endpoint(FamilyUsers, pathLegacyGetUsersByID), requestBody, nil, nil)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		var Response struct {
+			Data []*User `json:"data"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&Response)
+		if err != nil {
+			return err
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		for _, user := range Response.Data {
+			user.Client = c
+			users[user.ID.String()] = user
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, id := range ids {
+		if _, ok := users[strconv.FormatInt(id, 10)]; !ok {
+			notFound = append(notFound, strconv.FormatInt(id, 10))
+		}
+	}
+
+	return users, notFound, nil
+}
+
+// GetUsersByUsernames retrieves Roblox users in bulk by their usernames using the legacy Roblox API.
+//
+// The usernames are split into chunks of up to 100, which are requested concurrently.
+// It returns the users keyed by the username as it was provided, along with the usernames
+// that do not exist (or belong to banned users, when excludeBanned is true).
+// Only the ID, Username and Displayname fields of the returned users are populated;
+// use GetUserByID for the full Open Cloud profile.
+//
+// An error is returned if any request fails or a response cannot be decoded.
+//
+// Note: This method depends on the legacy endpoint at https://users.roblox.com/v1/usernames/users,
+// which may be deprecated or removed by Roblox in the future.
+func (c *Client) GetUsersByUsernames(usernames []string, excludeBanned bool) (map[string]*User, []string, error) {
+	return c.GetUsersByUsernamesContext(context.Background(), usernames, excludeBanned)
+}
+
+// GetUsersByUsernamesContext is like GetUsersByUsernames but uses the provided context for every request.
+func (c *Client) GetUsersByUsernamesContext(ctx context.Context, usernames []string, excludeBanned bool) (map[string]*User, []string, error) {
+	var names []string
+	requested := make(map[string][]string, len(usernames))
+	for _, username := range usernames {
+		key := strings.ToLower(username)
+		if _, ok := requested[key]; !ok {
+			names = append(names, username)
+		}
+		requested[key] = append(requested[key], username)
+	}
+
+	users := make(map[string]*User, len(usernames))
+	var mu sync.Mutex
+	err := forEachChunk(ctx, len(names), userBatchSize, userBatchConcurrency, func(ctx context.Context, start, end int) error {
+		requestBody := map[string]interface{}{"usernames": names[start:end], "excludeBannedUsers": excludeBanned}
+		resp, err := c.postIdempotent(ctx, c.endpoint(FamilyUsers, pathLegacyGetUsers), requestBody, nil, nil)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		var Response struct {
+			Data []struct {
+				User
+				RequestedUsername string `json:"requestedUsername"`
+			} `json:"data"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&Response)
+		if err != nil {
+			return err
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		for _, result := range Response.Data {
+			user := result.User
+			user.Client = c
+			for _, username := range requested[strings.ToLower(result.RequestedUsername)] {
+				users[username] = &user
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var notFound []string
+	for _, username := range usernames {
+		if _, ok := users[username]; !ok {
+			notFound = append(notFound, username)
+		}
+	}
+
+	return users, notFound, nil
+}
@@ -0,0 +1,379 @@
+package robloxgo
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// UserRestriction represents a user's game join restriction (ban) in a universe or place.
+type UserRestriction struct {
+	// Path is the Open Cloud resource path of the restriction.
+	Path string
+
+	// UserID is the unique identifier of the restricted user.
+	UserID string
+
+	// Active reports whether the user is currently banned.
+	Active bool
+
+	// StartedAt is the timestamp of when the ban started.
+	StartedAt time.Time
+
+	// Duration is how long the ban lasts, or 0 if it is permanent.
+	Duration time.Duration
+
+	// DisplayReason is the reason shown to the banned user.
+	DisplayReason string
+
+	// PrivateReason is the reason kept for moderators, which the user does not see.
+	PrivateReason string
+
+	// ExcludeAltAccounts reports whether the ban leaves the user's alternate accounts unbanned.
+	ExcludeAltAccounts bool
+
+	// Inherited reports whether a place restriction comes from its universe.
+	Inherited bool
+
+	// UpdatedAt is the timestamp of when the restriction was last updated.
+	UpdatedAt time.Time
+}
+
+// gameJoinRestriction is the wire format of a game join restriction, shared by restrictions and their logs.
+type gameJoinRestriction struct {
+	Active             bool      `json:"active"`
+	StartTime          time.Time `json:"startTime"`
+	Duration           string    `json:"duration"`
+	PrivateReason      string    `json:"privateReason"`
+	DisplayReason      string    `json:"displayReason"`
+	ExcludeAltAccounts bool      `json:"excludeAltAccounts"`
+	Inherited          bool      `json:"inherited"`
+}
+
+// UnmarshalJSON decodes an Open Cloud user restriction, flattening its game join restriction.
+func (r *UserRestriction) UnmarshalJSON(data []byte) error {
+	var restriction struct {
+		Path                string              `json:"path"`
+		User                string              `json:"user"`
+		UpdateTime          time.Time           `json:"updateTime"`
+		GameJoinRestriction gameJoinRestriction `json:"gameJoinRestriction"`
+	}
+	err := json.Unmarshal(data, &restriction)
+	if err != nil {
+		return err
+	}
+
+	duration, err := parseDuration(restriction.GameJoinRestriction.Duration)
+	if err != nil {
+		return err
+	}
+
+	*r = UserRestriction{
+		Path:               restriction.Path,
+		UserID:             strings.TrimPrefix(restriction.User, "users/"),
+		Active:             restriction.GameJoinRestriction.Active,
+		StartedAt:          restriction.GameJoinRestriction.StartTime,
+		Duration:           duration,
+		DisplayReason:      restriction.GameJoinRestriction.DisplayReason,
+		PrivateReason:      restriction.GameJoinRestriction.PrivateReason,
+		ExcludeAltAccounts: restriction.GameJoinRestriction.ExcludeAltAccounts,
+		Inherited:          restriction.GameJoinRestriction.Inherited,
+		UpdatedAt:          restriction.UpdateTime,
+	}
+
+	return nil
+}
+
+// UserRestrictionLog is an entry of a universe's user restriction audit log.
+type UserRestrictionLog struct {
+	// UserID is the unique identifier of the restricted user.
+	UserID string
+
+	// PlaceID is the place the restriction applies to, or empty for the whole universe.
+	PlaceID string
+
+	// ModeratorUserID is the user who made the change, or empty if it was made by a game server script.
+	ModeratorUserID string
+
+	// CreatedAt is the timestamp of when the change was made.
+	CreatedAt time.Time
+
+	// Active reports whether the change banned (true) or unbanned (false) the user.
+	Active bool
+
+	// StartedAt is the timestamp of when the ban started.
+	StartedAt time.Time
+
+	// Duration is how long the ban lasts, or 0 if it is permanent.
+	Duration time.Duration
+
+	// DisplayReason is the reason shown to the banned user.
+	DisplayReason string
+
+	// PrivateReason is the reason kept for moderators, which the user does not see.
+	PrivateReason string
+
+	// ExcludeAltAccounts reports whether the ban left the user's alternate accounts unbanned.
+	ExcludeAltAccounts bool
+}
+
+// UnmarshalJSON decodes an Open Cloud user restriction log entry.
+func (l *UserRestrictionLog) UnmarshalJSON(data []byte) error {
+	var log struct {
+		User      string `json:"user"`
+		Place     string `json:"place"`
+		Moderator struct {
+			RobloxUser string `json:"robloxUser"`
+		} `json:"moderator"`
+		CreateTime time.Time `json:"createTime"`
+		gameJoinRestriction
+	}
+	err := json.Unmarshal(data, &log)
+	if err != nil {
+		return err
+	}
+
+	duration, err := parseDuration(log.Duration)
+	if err != nil {
+		return err
+	}
+
+	*l = UserRestrictionLog{
+		UserID:             strings.TrimPrefix(log.User, "users/"),
+		PlaceID:            strings.TrimPrefix(log.Place, "places/"),
+		ModeratorUserID:    strings.TrimPrefix(log.Moderator.RobloxUser, "users/"),
+		CreatedAt:          log.CreateTime,
+		Active:             log.Active,
+		StartedAt:          log.StartTime,
+		Duration:           duration,
+		DisplayReason:      log.DisplayReason,
+		PrivateReason:      log.PrivateReason,
+		ExcludeAltAccounts: log.ExcludeAltAccounts,
+	}
+
+	return nil
+}
+
+// BanOptions holds the details of a ban made with BanUser.
+type BanOptions struct {
+	// Duration is how long the ban lasts. Leave it at 0 for a permanent ban.
+	Duration time.Duration
+
+	// DisplayReason is the reason shown to the banned user.
+	DisplayReason string
+
+	// PrivateReason is the reason kept for moderators, which the user does not see.
+	PrivateReason string
+
+	// ExcludeAltAccounts leaves the user's alternate accounts unbanned.
+	ExcludeAltAccounts bool
+}
+
+// RestrictionLogOptions filters the entries returned by ListUserRestrictionLogs.
+type RestrictionLogOptions struct {
+	PageOptions
+
+	// UserID, if set, only returns the changes made to the user's restrictions.
+	UserID string
+
+	// PlaceID, if set, only returns the changes made to restrictions of the place.
+	PlaceID string
+}
+
+// BanUser bans a user from joining the universe.
+//
+// It returns the user's updated restriction.
+// Returns an error if the user ID is empty, the HTTP request fails, or the response cannot be decoded.
+func (u *Universe) BanUser(userID string, opts BanOptions) (*UserRestriction, error) {
+	return u.BanUserContext(context.Background(), userID, opts)
+}
+
+// BanUserContext is like BanUser but uses the provided context for the request.
+func (u *Universe) BanUserContext(ctx context.Context, userID string, opts BanOptions) (*UserRestriction, error) {
+	if u.ID == "" {
+		return nil, ErrNoUniverseID
+	}
+
+	return u.Client.updateUserRestriction(ctx, u.cloudPath(""), userID, banRestriction(opts))
+}
+
+// UnbanUser lifts a user's ban from the universe.
+//
+// It returns the user's updated restriction.
+// Returns an error if the user ID is empty, the HTTP request fails, or the response cannot be decoded.
+func (u *Universe) UnbanUser(userID string) (*UserRestriction, error) {
+	return u.UnbanUserContext(context.Background(), userID)
+}
+
+// UnbanUserContext is like UnbanUser but uses the provided context for the request.
+func (u *Universe) UnbanUserContext(ctx context.Context, userID string) (*UserRestriction, error) {
+	if u.ID == "" {
+		return nil, ErrNoUniverseID
+	}
+
+	return u.Client.updateUserRestriction(ctx, u.cloudPath(""), userID, map[string]interface{}{"active": false})
+}
+
+// GetUserRestriction retrieves a user's restriction in the universe.
+//
+// Returns an error if the user ID is empty, the HTTP request fails, or the response cannot be decoded.
+func (u *Universe) GetUserRestriction(userID string) (*UserRestriction, error) {
+	return u.GetUserRestrictionContext(context.Background(), userID)
+}
+
+// GetUserRestrictionContext is like GetUserRestriction but uses the provided context for the request.
+func (u *Universe) GetUserRestrictionContext(ctx context.Context, userID string) (*UserRestriction, error) {
+	if u.ID == "" {
+		return nil, ErrNoUniverseID
+	}
+
+	return u.Client.getUserRestriction(ctx, u.cloudPath(""), userID)
+}
+
+// ListUserRestrictions returns a Pager over the user restrictions of the universe.
+// Pages are only fetched as the caller iterates.
+func (u *Universe) ListUserRestrictions(opts PageOptions) *Pager[UserRestriction] {
+	return newPager[UserRestriction](u.Client, u.Client.cloudEndpoint(u.cloudPath("/user-restrictions")), "userRestrictions", nil, opts)
+}
+
+// ListUserRestrictionLogs returns a Pager over the audit log of the universe's user restrictions,
+// newest first, optionally filtered by user and place. Pages are only fetched as the caller iterates.
+func (u *Universe) ListUserRestrictionLogs(opts RestrictionLogOptions) *Pager[UserRestrictionLog] {
+	var filters []string
+	if opts.UserID != "" {
+		filters = append(filters, "user == 'users/"+opts.UserID+"'")
+	}
+	if opts.PlaceID != "" {
+		filters = append(filters, "place == 'places/"+opts.PlaceID+"'")
+	}
+	var query []queryParam
+	if len(filters) > 0 {
+		query = append(query, queryParam{Key: "filter", Value: strings.Join(filters, " && ")})
+	}
+
+	return newPager[UserRestrictionLog](u.Client, u.Client.cloudEndpoint(u.cloudPath("/user-restrictions:listLogs")), "logs", query, opts.PageOptions)
+}
+
+// BanUser bans a user from joining the place.
+//
+// It returns the user's updated restriction.
+// Returns an error if the user ID is empty, the HTTP request fails, or the response cannot be decoded.
+func (p *Place) BanUser(userID string, opts BanOptions) (*UserRestriction, error) {
+	return p.BanUserContext(context.Background(), userID, opts)
+}
+
+// BanUserContext is like BanUser but uses the provided context for the request.
+func (p *Place) BanUserContext(ctx context.Context, userID string, opts BanOptions) (*UserRestriction, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return p.Universe.Client.updateUserRestriction(ctx, p.cloudPath(""), userID, banRestriction(opts))
+}
+
+// UnbanUser lifts a user's ban from the place.
+//
+// It returns the user's updated restriction.
+// Returns an error if the user ID is empty, the HTTP request fails, or the response cannot be decoded.
+func (p *Place) UnbanUser(userID string) (*UserRestriction, error) {
+	return p.UnbanUserContext(context.Background(), userID)
+}
+
+// UnbanUserContext is like UnbanUser but uses the provided context for the request.
+func (p *Place) UnbanUserContext(ctx context.Context, userID string) (*UserRestriction, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return p.Universe.Client.updateUserRestriction(ctx, p.cloudPath(""), userID, map[string]interface{}{"active": false})
+}
+
+// GetUserRestriction retrieves a user's restriction in the place, which may be inherited from its universe.
+//
+// Returns an error if the user ID is empty, the HTTP request fails, or the response cannot be decoded.
+func (p *Place) GetUserRestriction(userID string) (*UserRestriction, error) {
+	return p.GetUserRestrictionContext(context.Background(), userID)
+}
+
+// GetUserRestrictionContext is like GetUserRestriction but uses the provided context for the request.
+func (p *Place) GetUserRestrictionContext(ctx context.Context, userID string) (*UserRestriction, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return p.Universe.Client.getUserRestriction(ctx, p.cloudPath(""), userID)
+}
+
+// ListUserRestrictions returns a Pager over the user restrictions of the place.
+// Pages are only fetched as the caller iterates.
+func (p *Place) ListUserRestrictions(opts PageOptions) *Pager[UserRestriction] {
+	client := p.Universe.Client
+	return newPager[UserRestriction](client, client.cloudEndpoint(p.cloudPath("/user-restrictions")), "userRestrictions", nil, opts)
+}
+
+// ListUserRestrictionLogs returns a Pager over the audit log of the place's user restrictions,
+// newest first, optionally filtered by user. Pages are only fetched as the caller iterates.
+func (p *Place) ListUserRestrictionLogs(opts RestrictionLogOptions) *Pager[UserRestrictionLog] {
+	opts.PlaceID = p.ID
+	return p.Universe.ListUserRestrictionLogs(opts)
+}
+
+// banRestriction returns the game join restriction that bans a user with the provided options.
+func banRestriction(opts BanOptions) map[string]interface{} {
+	restriction := map[string]interface{}{
+		"active":             true,
+		"displayReason":      opts.DisplayReason,
+		"privateReason":      opts.PrivateReason,
+		"excludeAltAccounts": opts.ExcludeAltAccounts,
+	}
+	if opts.Duration > 0 {
+		restriction["duration"] = formatDuration(opts.Duration)
+	}
+
+	return restriction
+}
+
+// getUserRestriction fetches the restriction of a user under the universe or place at parent.
+func (c *Client) getUserRestriction(ctx context.Context, parent string, userID string) (*UserRestriction, error) {
+	if userID == "" {
+		return nil, ErrNoUserID
+	}
+
+	resp, err := c.get(ctx, c.cloudEndpoint(parent+"/user-restrictions/"+userID), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	restriction := &UserRestriction{}
+	err = json.NewDecoder(resp.Body).Decode(restriction)
+	if err != nil {
+		return nil, err
+	}
+
+	return restriction, nil
+}
+
+// updateUserRestriction replaces the game join restriction of a user under the universe or place at parent.
+func (c *Client) updateUserRestriction(ctx context.Context, parent string, userID string, restriction map[string]interface{}) (*UserRestriction, error) {
+	if userID == "" {
+		return nil, ErrNoUserID
+	}
+
+	requestBody := map[string]interface{}{"gameJoinRestriction": restriction}
+	query := []queryParam{{Key: "updateMask", Value: "gameJoinRestriction"}}
+	resp, err := c.patch(ctx, c.cloudEndpoint(parent+"/user-restrictions/"+userID), requestBody, nil, query)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	updated := &UserRestriction{}
+	err = json.NewDecoder(resp.Body).Decode(updated)
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
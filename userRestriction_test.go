@@ -0,0 +1,64 @@
+package robloxgo
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestPlace_BanUser(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/cloud/v2/universes/9/places/3/user-restrictions/42" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if mask := r.URL.Query().Get("updateMask"); mask != "gameJoinRestriction" {
+			t.Errorf("unexpected update mask %q", mask)
+		}
+		var body struct {
+			GameJoinRestriction map[string]interface{} `json:"gameJoinRestriction"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		restriction := body.GameJoinRestriction
+		if restriction["active"] != true || restriction["duration"] != "86400s" || restriction["excludeAltAccounts"] != true {
+			t.Errorf("unexpected restriction %v", restriction)
+		}
+		io.WriteString(w, `{"path":"universes/9/places/3/user-restrictions/42","user":"users/42",
+			"gameJoinRestriction":{"active":true,"duration":"86400s","displayReason":"exploiting","excludeAltAccounts":true}}`)
+	})
+
+	restriction, err := client.Universe("9").Place("3").BanUser("42", BanOptions{
+		Duration:           24 * time.Hour,
+		DisplayReason:      "exploiting",
+		ExcludeAltAccounts: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if restriction.UserID != "42" || !restriction.Active || restriction.Duration != 24*time.Hour || restriction.DisplayReason != "exploiting" {
+		t.Fatalf("unexpected restriction: %+v", restriction)
+	}
+}
+
+func TestUniverse_ListUserRestrictionLogs(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/cloud/v2/universes/9/user-restrictions:listLogs" {
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+		if filter := r.URL.Query().Get("filter"); filter != "user == 'users/42' && place == 'places/3'" {
+			t.Errorf("unexpected filter %q", filter)
+		}
+		io.WriteString(w, `{"logs":[{"user":"users/42","place":"places/3","moderator":{"robloxUser":"users/1"},
+			"active":false,"duration":"1.5s","privateReason":"appeal accepted"}]}`)
+	})
+
+	logs, err := client.Universe("9").Place("3").ListUserRestrictionLogs(RestrictionLogOptions{UserID: "42"}).All(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(logs) != 1 || logs[0].ModeratorUserID != "1" || logs[0].PlaceID != "3" || logs[0].Duration != 1500*time.Millisecond || logs[0].PrivateReason != "appeal accepted" {
+		t.Fatalf("unexpected logs: %+v", logs)
+	}
+}
@@ -1,7 +1,11 @@
 package robloxgo
 
 import (
+	"encoding/json"
+	"net/http"
 	"os"
+	"strconv"
+	"sync/atomic"
 	"testing"
 )
 
@@ -103,3 +107,65 @@ func TestGetUserThumnail(t *testing.T) {
 		t.Fatal("expected user, got nil")
 	}
 }
+
+func TestGetUsersByIDs_Chunks(t *testing.T) {
+	var requests int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		var body struct {
+			UserIDs []int64 `json:"userIds"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		if len(body.UserIDs) > 100 {
+			t.Errorf("expected at most 100 ids per request, got %d", len(body.UserIDs))
+		}
+
+		var data []map[string]interface{}
+		for _, id := range body.UserIDs {
+			if id%2 == 0 {
+				data = append(data, map[string]interface{}{"id": id, "name": "user" + strconv.FormatInt(id, 10)})
+			}
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+	})
+
+	var userIDs []string
+	for i := 1; i <= 250; i++ {
+		userIDs = append(userIDs, strconv.Itoa(i))
+	}
+	userIDs = append(userIDs, "not-a-number")
+
+	users, notFound, err := client.GetUsersByIDs(userIDs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if requests != 3 {
+		t.Fatalf("expected 3 requests, got %d", requests)
+	}
+	if len(users) != 125 || users["42"].Username != "user42" {
+		t.Fatalf("unexpected users: %d", len(users))
+	}
+	if len(notFound) != 126 {
+		t.Fatalf("expected 126 not found, got %d", len(notFound))
+	}
+}
+
+func TestGetUsersByUsernames_NotFound(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/usernames/users" {
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+		w.Write([]byte(`{"data":[{"requestedUsername":"roblox","id":1,"name":"Roblox"}]}`))
+	})
+
+	users, notFound, err := client.GetUsersByUsernames([]string{"roblox", "nobody"}, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if users["roblox"] == nil || users["roblox"].ID.String() != "1" {
+		t.Fatalf("unexpected users: %v", users)
+	}
+	if len(notFound) != 1 || notFound[0] != "nobody" {
+		t.Fatalf("unexpected not found: %v", notFound)
+	}
+}